@@ -0,0 +1,67 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList = "kubernetes_admissionregistration_v1_validatingwebhookconfiguration_list"
+	KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList   = "kubernetes_admissionregistration_v1_mutatingwebhookconfiguration_list"
+)
+
+var _ detek.Collector = &K8sAdmissionRegistrationV1Collector{}
+
+type K8sAdmissionRegistrationV1Collector struct{}
+
+func (*K8sAdmissionRegistrationV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_admissionregistration_v1",
+			Description: "collect admissionregistration v1 resources from kubernetes",
+			Labels:      []string{"kubernetes", "admissionregistration.k8s.io/v1", "webhook", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList: {Type: detek.TypeOf(v1.ValidatingWebhookConfigurationList{})},
+			KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList:   {Type: detek.TypeOf(v1.MutatingWebhookConfigurationList{})},
+		},
+	}
+}
+
+func (*K8sAdmissionRegistrationV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if validatingList, err := c.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get validating webhook configuration list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList, *validatingList),
+		)
+	}
+
+	if mutatingList, err := c.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get mutating webhook configuration list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList, *mutatingList),
+		)
+	}
+
+	return errs.ErrorOrNil()
+}
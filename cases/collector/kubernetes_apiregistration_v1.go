@@ -0,0 +1,82 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	KeyK8sAPIRegistrationV1APIServices = "kubernetes_apiregistration_v1_apiservices"
+)
+
+var apiRegistrationV1APIServices = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+
+// APIService is a subset of apiregistration.k8s.io/v1 APIService.
+// (k8s.io/kube-aggregator is not a dependency of detek, so it is collected with a dynamic client)
+type APIService struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		// nil for apis served by the kubernetes api server itself
+		Service *struct {
+			Namespace string `json:"namespace"`
+			Name      string `json:"name"`
+			Port      *int32 `json:"port,omitempty"`
+		} `json:"service,omitempty"`
+		Group   string `json:"group,omitempty"`
+		Version string `json:"version,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		Conditions []metav1.Condition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+var _ detek.Collector = &K8sAPIRegistrationV1Collector{}
+
+type K8sAPIRegistrationV1Collector struct{}
+
+func (*K8sAPIRegistrationV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_apiregistration_v1",
+			Description: "collect apiregistration v1 apiservices from kubernetes",
+			Labels:      []string{"kubernetes", "apiregistration.k8s.io/v1", "apiservice", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sRestConfig: {Type: detek.TypeOf(&rest.Config{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sAPIRegistrationV1APIServices: {Type: detek.TypeOf([]APIService{})},
+		},
+	}
+}
+
+func (*K8sAPIRegistrationV1Collector) Do(dctx detek.DetekContext) error {
+	config, err := detek.Typing[*rest.Config](
+		dctx.Get(KeyK8sRestConfig, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes rest config: %w", err)
+	}
+	cli, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("fail to generate dynamic client: %w", err)
+	}
+
+	list, err := cli.Resource(apiRegistrationV1APIServices).List(dctx.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("fail to get apiservice list from kubernetes: %w", err)
+	}
+	apiServices := make([]APIService, len(list.Items))
+	for i, item := range list.Items {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &apiServices[i]); err != nil {
+			return fmt.Errorf("fail to convert apiservice %q: %w", item.GetName(), err)
+		}
+	}
+	return dctx.Set(KeyK8sAPIRegistrationV1APIServices, apiServices)
+}
@@ -1,13 +1,18 @@
 package collector
 
 import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/kakao/detek/pkg/detek"
 	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 const (
-	KeyK8sAppsV1DeploymentList = "kubernetes_apps_v1_deployment_list"
+	KeyK8sAppsV1DeploymentList  = "kubernetes_apps_v1_deployment_list"
+	KeyK8sAppsV1StatefulSetList = "kubernetes_apps_v1_statefulset_list"
 )
 
 var _ detek.Collector = &K8sAppsV1Collector{}
@@ -25,11 +30,38 @@ func (*K8sAppsV1Collector) GetMeta() detek.CollectorInfo {
 			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
 		},
 		Producing: detek.DependencyMeta{
-			KeyK8sAppsV1DeploymentList: {Type: detek.TypeOf(v1.DeploymentList{})},
+			KeyK8sAppsV1DeploymentList:  {Type: detek.TypeOf(v1.DeploymentList{})},
+			KeyK8sAppsV1StatefulSetList: {Type: detek.TypeOf(v1.StatefulSetList{})},
 		},
 	}
 }
 
-func (*K8sAppsV1Collector) Do(ctx detek.DetekContext) error {
-	panic("unimplemented")
+func (*K8sAppsV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if deploymentList, err := c.AppsV1().Deployments("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get deployment list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sAppsV1DeploymentList, *deploymentList),
+		)
+	}
+
+	if statefulSetList, err := c.AppsV1().StatefulSets("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get statefulset list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sAppsV1StatefulSetList, *statefulSetList),
+		)
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -0,0 +1,49 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	v2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sAutoscalingV2HorizontalPodAutoscalerList = "kubernetes_autoscaling_v2_horizontalpodautoscaler_list"
+)
+
+var _ detek.Collector = &K8sAutoscalingV2Collector{}
+
+type K8sAutoscalingV2Collector struct{}
+
+func (*K8sAutoscalingV2Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_autoscaling_v2",
+			Description: "collect autoscaling v2 resources from kubernetes",
+			Labels:      []string{"kubernetes", "autoscaling/v2", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+		},
+	}
+}
+
+func (*K8sAutoscalingV2Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	hpaList, err := c.AutoscalingV2().HorizontalPodAutoscalers("").List(dctx.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("fail to get horizontal pod autoscaler list from kubernetes: %w", err)
+	}
+	return dctx.Set(KeyK8sAutoscalingV2HorizontalPodAutoscalerList, *hpaList)
+}
@@ -0,0 +1,67 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sBatchV1JobList     = "kubernetes_batch_v1_job_list"
+	KeyK8sBatchV1CronJobList = "kubernetes_batch_v1_cronjob_list"
+)
+
+var _ detek.Collector = &K8sBatchV1Collector{}
+
+type K8sBatchV1Collector struct{}
+
+func (*K8sBatchV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_batch_v1",
+			Description: "collect batch v1 resources from kubernetes",
+			Labels:      []string{"kubernetes", "batch/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sBatchV1JobList:     {Type: detek.TypeOf(v1.JobList{})},
+			KeyK8sBatchV1CronJobList: {Type: detek.TypeOf(v1.CronJobList{})},
+		},
+	}
+}
+
+func (*K8sBatchV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if jobList, err := c.BatchV1().Jobs("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get job list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sBatchV1JobList, *jobList),
+		)
+	}
+
+	if cronJobList, err := c.BatchV1().CronJobs("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get cronjob list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sBatchV1CronJobList, *cronJobList),
+		)
+	}
+
+	return errs.ErrorOrNil()
+}
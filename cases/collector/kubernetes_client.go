@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -37,6 +38,8 @@ func (*K8sClientCollector) GetMeta() detek.CollectorInfo {
 			KeyK8sClient:     {Type: detek.TypeOf(&kubernetes.Clientset{})},
 			KeyK8sRestConfig: {Type: detek.TypeOf(&rest.Config{})},
 			KeyK8sVersion:    {Type: detek.TypeOf(version.Info{})},
+
+			detek.KeyClusterInfo: {Type: detek.TypeOf(detek.ClusterInfo{})},
 		},
 	}
 }
@@ -45,11 +48,14 @@ func (c *K8sClientCollector) Do(ctx detek.DetekContext) error {
 
 	var config *rest.Config
 	var err error
+	var usedKubeconfig string
 	if c.KubeconfigPath != "" {
 		//   1. kubeconfig file located by "--kubeconfig" flag
+		usedKubeconfig = c.KubeconfigPath
 		config, err = clientcmd.BuildConfigFromFlags("", c.KubeconfigPath)
 	} else if kubeconfigPath := os.Getenv("KUBECONFIG"); kubeconfigPath != "" {
 		//   2. kubeconfig file located by "KUBECONFIG" env
+		usedKubeconfig = kubeconfigPath
 		config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
 	} else {
 		//   3. in-cluster client configuration (useful when using detek in a kubernetes cluster)
@@ -58,6 +64,7 @@ func (c *K8sClientCollector) Do(ctx detek.DetekContext) error {
 	if err != nil {
 		//   4. kubeconfig file located in default directory ($HOME/.kube/config)`,
 		kubeconfigPath := filepath.Join(homedir.HomeDir(), ".kube", "config")
+		usedKubeconfig = kubeconfigPath
 		config, err = clientcmd.BuildConfigFromFlags("", kubeconfigPath)
 	}
 
@@ -85,5 +92,23 @@ func (c *K8sClientCollector) Do(ctx detek.DetekContext) error {
 	if err := ctx.Set(KeyK8sVersion, *vi); err != nil {
 		return err
 	}
-	return nil
+
+	info := detek.ClusterInfo{
+		Name:    "in-cluster",
+		Server:  config.Host,
+		Version: vi.GitVersion,
+	}
+	if usedKubeconfig != "" {
+		if raw, err := clientcmd.LoadFromFile(usedKubeconfig); err == nil {
+			if kctx, ok := raw.Contexts[raw.CurrentContext]; ok {
+				info.Name = kctx.Cluster
+			}
+		}
+	}
+	// UID of "kube-system" namespace is commonly used as a cluster identifier.
+	// it is not an error, even if it is not allowed to get namespaces.
+	if ns, err := clientset.CoreV1().Namespaces().Get(ctx.Context(), metav1.NamespaceSystem, metav1.GetOptions{}); err == nil {
+		info.UID = string(ns.UID)
+	}
+	return ctx.Set(detek.KeyClusterInfo, info)
 }
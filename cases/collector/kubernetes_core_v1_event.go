@@ -0,0 +1,91 @@
+package collector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sCoreV1EventList = "kubernetes_core_v1_eventlist"
+)
+
+var _ detek.Collector = &K8sCoreV1EventCollector{}
+
+type K8sCoreV1EventCollector struct {
+	// (optional) field selector to filter events. (e.g, "reason=FailedScheduling")
+	FieldSelector string
+	// (optional) events last seen before this will be dropped. (0 means no limit)
+	MaxAge time.Duration
+}
+
+func (c *K8sCoreV1EventCollector) GetMeta() detek.CollectorInfo {
+	desc := "collect core v1 events from kubernetes"
+	if c.FieldSelector != "" {
+		desc += fmt.Sprintf(" (field selector: %q)", c.FieldSelector)
+	}
+	if c.MaxAge != 0 {
+		desc += fmt.Sprintf(" (within %s)", c.MaxAge)
+	}
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_core_v1_event",
+			Description: desc,
+			Labels:      []string{"kubernetes", "core/v1", "event"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sCoreV1EventList: {Type: detek.TypeOf(v1.EventList{})},
+		},
+	}
+}
+
+func (c *K8sCoreV1EventCollector) Do(dctx detek.DetekContext) error {
+	cli, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	eventList, err := cli.CoreV1().Events("").List(dctx.Context(), metav1.ListOptions{
+		FieldSelector: c.FieldSelector,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to get event list from kubernetes: %w", err)
+	}
+
+	if c.MaxAge != 0 {
+		since := time.Now().Add(-c.MaxAge)
+		items := []v1.Event{}
+		for _, ev := range eventList.Items {
+			if LastSeenOf(ev).After(since) {
+				items = append(items, ev)
+			}
+		}
+		eventList.Items = items
+	}
+	return dctx.Set(KeyK8sCoreV1EventList, *eventList)
+}
+
+// LastSeenOf returns when a given event is observed lastly.
+func LastSeenOf(ev v1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.FirstTimestamp.IsZero():
+		return ev.FirstTimestamp.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
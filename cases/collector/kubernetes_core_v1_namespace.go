@@ -0,0 +1,72 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sCoreV1NamespaceList     = "kubernetes_core_v1_namespacelist"
+	KeyK8sCoreV1ResourceQuotaList = "kubernetes_core_v1_resourcequotalist"
+	KeyK8sCoreV1LimitRangeList    = "kubernetes_core_v1_limitrangelist"
+)
+
+var _ detek.Collector = &K8sCoreV1NamespaceCollector{}
+
+// K8sCoreV1NamespaceCollector collects Namespaces, and core v1 resources governing them (ResourceQuotas, LimitRanges).
+type K8sCoreV1NamespaceCollector struct{}
+
+func (*K8sCoreV1NamespaceCollector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_core_v1_namespace",
+			Description: "collect core v1 namespace, resource quota and limit range resources from kubernetes",
+			Labels:      []string{"kubernetes", "core/v1", "namespace", "manifest"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sCoreV1NamespaceList:     {Type: detek.TypeOf(v1.NamespaceList{})},
+			KeyK8sCoreV1ResourceQuotaList: {Type: detek.TypeOf(v1.ResourceQuotaList{})},
+			KeyK8sCoreV1LimitRangeList:    {Type: detek.TypeOf(v1.LimitRangeList{})},
+		},
+	}
+}
+
+func (*K8sCoreV1NamespaceCollector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if nsList, err := c.CoreV1().Namespaces().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get namespace list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sCoreV1NamespaceList, *nsList))
+	}
+
+	if quotaList, err := c.CoreV1().ResourceQuotas("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get resource quota list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sCoreV1ResourceQuotaList, *quotaList))
+	}
+
+	if limitRangeList, err := c.CoreV1().LimitRanges("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get limit range list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sCoreV1LimitRangeList, *limitRangeList))
+	}
+
+	return errs.ErrorOrNil()
+}
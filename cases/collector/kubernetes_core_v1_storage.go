@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sCoreV1PersistentVolumeClaimList = "kubernetes_core_v1_persistentvolumeclaimlist"
+	KeyK8sCoreV1PersistentVolumeList      = "kubernetes_core_v1_persistentvolumelist"
+)
+
+var _ detek.Collector = &K8sCoreV1StorageCollector{}
+
+// K8sCoreV1StorageCollector collects storage related core v1 resources (PersistentVolumeClaims, PersistentVolumes).
+type K8sCoreV1StorageCollector struct{}
+
+func (*K8sCoreV1StorageCollector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_core_v1_storage",
+			Description: "collect core v1 persistent volume (claim) resources from kubernetes",
+			Labels:      []string{"kubernetes", "core/v1", "storage", "manifest"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sCoreV1PersistentVolumeClaimList: {Type: detek.TypeOf(v1.PersistentVolumeClaimList{})},
+			KeyK8sCoreV1PersistentVolumeList:      {Type: detek.TypeOf(v1.PersistentVolumeList{})},
+		},
+	}
+}
+
+func (*K8sCoreV1StorageCollector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if pvcList, err := c.CoreV1().PersistentVolumeClaims("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get persistent volume claim list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sCoreV1PersistentVolumeClaimList, *pvcList),
+		)
+	}
+
+	if pvList, err := c.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get persistent volume list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sCoreV1PersistentVolumeList, *pvList),
+		)
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,103 @@
+package collector
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sTLSCertificates = "kubernetes_tls_certificates"
+)
+
+// TLSCertificate is metadata of a certificate in a "kubernetes.io/tls" Secret.
+// (contents of Secrets, including private keys, are never stored)
+type TLSCertificate struct {
+	Namespace string
+	Name      string
+	Subject   string
+	Issuer    string
+	DNSNames  []string
+	NotBefore time.Time
+	NotAfter  time.Time
+	// set if the certificate can not be parsed
+	Error string `json:",omitempty"`
+}
+
+var _ detek.Collector = &K8sCoreV1TLSSecretCollector{}
+
+// K8sCoreV1TLSSecretCollector collects metadata of certificates in "kubernetes.io/tls" Secrets.
+type K8sCoreV1TLSSecretCollector struct{}
+
+func (*K8sCoreV1TLSSecretCollector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_core_v1_tls_secret",
+			Description: "collect metadata of certificates in kubernetes.io/tls secrets from kubernetes",
+			Labels:      []string{"kubernetes", "core/v1", "secret", "tls"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sTLSCertificates: {Type: detek.TypeOf([]TLSCertificate{})},
+		},
+	}
+}
+
+func (*K8sCoreV1TLSSecretCollector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	secretList, err := c.CoreV1().Secrets("").List(dctx.Context(), metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("type", string(v1.SecretTypeTLS)).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("fail to get secret list from kubernetes: %w", err)
+	}
+
+	certs := []TLSCertificate{}
+	for _, secret := range secretList.Items {
+		certs = append(certs, tlsCertificateOf(secret))
+	}
+	return dctx.Set(KeyK8sTLSCertificates, certs)
+}
+
+// tlsCertificateOf parses the leaf certificate in a given Secret.
+func tlsCertificateOf(secret v1.Secret) TLSCertificate {
+	result := TLSCertificate{
+		Namespace: secret.Namespace,
+		Name:      secret.Name,
+	}
+	block, _ := pem.Decode(secret.Data[v1.TLSCertKey])
+	if block == nil || block.Type != "CERTIFICATE" {
+		result.Error = fmt.Sprintf("no PEM encoded certificate in %q", v1.TLSCertKey)
+		return result
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		result.Error = fmt.Sprintf("fail to parse certificate: %v", err)
+		return result
+	}
+	result.Subject = cert.Subject.String()
+	result.Issuer = cert.Issuer.String()
+	result.DNSNames = cert.DNSNames
+	if len(result.DNSNames) == 0 && cert.Subject.CommonName != "" {
+		// legacy certificates without SANs
+		result.DNSNames = []string{cert.Subject.CommonName}
+	}
+	result.NotBefore = cert.NotBefore
+	result.NotAfter = cert.NotAfter
+	return result
+}
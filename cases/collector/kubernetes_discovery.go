@@ -0,0 +1,77 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sAPIResourceLists = "kubernetes_api_resource_lists"
+)
+
+var _ detek.Collector = &K8sDiscoveryCollector{}
+
+type K8sDiscoveryCollector struct{}
+
+func (*K8sDiscoveryCollector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_discovery",
+			Description: "discover every group/version and resource served by kubernetes",
+			Labels:      []string{"kubernetes", "discovery", "api"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sAPIResourceLists: {Type: detek.TypeOf([]metav1.APIResourceList{})},
+		},
+	}
+}
+
+func (*K8sDiscoveryCollector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	_, lists, err := c.Discovery().ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return fmt.Errorf("fail to discover apis from kubernetes: %w", err)
+	}
+	// some of aggregated apis may be unavailable, but the others are still valid.
+	result := []metav1.APIResourceList{}
+	for _, l := range lists {
+		if l != nil {
+			result = append(result, *l)
+		}
+	}
+	if setErr := dctx.Set(KeyK8sAPIResourceLists, result); setErr != nil {
+		return setErr
+	}
+	return err
+}
+
+// IsServed returns whether a given group/version and resource is served. (if resource is empty, check group/version only)
+func IsServed(lists []metav1.APIResourceList, groupVersion, resource string) bool {
+	for _, l := range lists {
+		if l.GroupVersion != groupVersion {
+			continue
+		}
+		if resource == "" {
+			return true
+		}
+		for _, r := range l.APIResources {
+			if r.Name == resource {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sDiscoveryV1EndpointSliceList = "kubernetes_discovery_v1_endpointslicelist"
+	// endpoints of services, from EndpointSlices (or Endpoints, if EndpointSlices are not served)
+	KeyK8sServiceEndpoints = "kubernetes_service_endpoints"
+)
+
+const (
+	EndpointSourceEndpointSlice = "EndpointSlice"
+	EndpointSourceEndpoints     = "Endpoints"
+)
+
+// ServiceEndpoints is a list of endpoints of a Service.
+type ServiceEndpoints struct {
+	Namespace string
+	Name      string
+	// where endpoints come from (EndpointSourceEndpointSlice or EndpointSourceEndpoints)
+	Source    string
+	Endpoints []ServiceEndpoint
+}
+
+// ServiceEndpoint is an endpoint of a Service.
+// (Serving and Terminating are always false for endpoints from legacy Endpoints, if not ready)
+type ServiceEndpoint struct {
+	Address     string
+	TargetRef   *v1.ObjectReference
+	Ready       bool
+	Serving     bool
+	Terminating bool
+}
+
+// String returns a text representation of the endpoint. (e.g, "10.0.0.1 (Pod/default/nginx)")
+func (ep ServiceEndpoint) String() string {
+	if ref := ep.TargetRef; ref != nil {
+		return fmt.Sprintf("%s (%s/%s/%s)", ep.Address, ref.Kind, ref.Namespace, ref.Name)
+	}
+	return ep.Address
+}
+
+var _ detek.Collector = &K8sDiscoveryV1Collector{}
+
+type K8sDiscoveryV1Collector struct{}
+
+func (*K8sDiscoveryV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_discovery_v1",
+			Description: "collect discovery v1 resources from kubernetes, and endpoints of services (falling back to core v1 Endpoints in older clusters)",
+			Labels:      []string{"kubernetes", "discovery/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sDiscoveryV1EndpointSliceList: {Type: detek.TypeOf(discoveryv1.EndpointSliceList{})},
+			KeyK8sServiceEndpoints:             {Type: detek.TypeOf([]ServiceEndpoints{})},
+		},
+	}
+}
+
+func (*K8sDiscoveryV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	ctx := dctx.Context()
+
+	var serviceEndpoints []ServiceEndpoints
+	sliceList, err := c.DiscoveryV1().EndpointSlices("").List(ctx, metav1.ListOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		// discovery.k8s.io/v1 is served since kubernetes v1.21
+		sliceList = &discoveryv1.EndpointSliceList{}
+		epList, err := c.CoreV1().Endpoints("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("fail to get endpoints list from kubernetes: %w", err)
+		}
+		serviceEndpoints = fromEndpoints(*epList)
+	case err != nil:
+		return fmt.Errorf("fail to get endpointslice list from kubernetes: %w", err)
+	default:
+		serviceEndpoints = fromEndpointSlices(*sliceList)
+	}
+
+	if err := dctx.Set(KeyK8sDiscoveryV1EndpointSliceList, *sliceList); err != nil {
+		return err
+	}
+	return dctx.Set(KeyK8sServiceEndpoints, serviceEndpoints)
+}
+
+func fromEndpointSlices(sliceList discoveryv1.EndpointSliceList) []ServiceEndpoints {
+	result := []ServiceEndpoints{}
+	index := map[types.NamespacedName]int{}
+	// an endpoint may be in multiple slices (e.g, dual-stack services)
+	seen := map[types.NamespacedName]map[string]bool{}
+	for _, slice := range sliceList.Items {
+		name, ok := slice.Labels[discoveryv1.LabelServiceName]
+		if !ok {
+			continue
+		}
+		key := types.NamespacedName{Namespace: slice.Namespace, Name: name}
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			seen[key] = map[string]bool{}
+			result = append(result, ServiceEndpoints{
+				Namespace: slice.Namespace,
+				Name:      name,
+				Source:    EndpointSourceEndpointSlice,
+				Endpoints: []ServiceEndpoint{},
+			})
+		}
+		for _, ep := range slice.Endpoints {
+			if len(ep.Addresses) == 0 {
+				continue
+			}
+			id := ep.Addresses[0]
+			if ep.TargetRef != nil && ep.TargetRef.UID != "" {
+				id = string(ep.TargetRef.UID)
+			}
+			if seen[key][id] {
+				continue
+			}
+			seen[key][id] = true
+			cond := ep.Conditions
+			result[i].Endpoints = append(result[i].Endpoints, ServiceEndpoint{
+				Address:   ep.Addresses[0],
+				TargetRef: ep.TargetRef,
+				// nil ready (or serving) condition should be interpreted as true
+				Ready:       cond.Ready == nil || *cond.Ready,
+				Serving:     cond.Serving == nil || *cond.Serving,
+				Terminating: cond.Terminating != nil && *cond.Terminating,
+			})
+		}
+	}
+	return result
+}
+
+func fromEndpoints(epList v1.EndpointsList) []ServiceEndpoints {
+	result := []ServiceEndpoints{}
+	for _, ep := range epList.Items {
+		se := ServiceEndpoints{
+			Namespace: ep.Namespace,
+			Name:      ep.Name,
+			Source:    EndpointSourceEndpoints,
+			Endpoints: []ServiceEndpoint{},
+		}
+		for _, sub := range ep.Subsets {
+			for _, addr := range sub.Addresses {
+				se.Endpoints = append(se.Endpoints, ServiceEndpoint{
+					Address: addr.IP, TargetRef: addr.TargetRef, Ready: true, Serving: true,
+				})
+			}
+			for _, addr := range sub.NotReadyAddresses {
+				se.Endpoints = append(se.Endpoints, ServiceEndpoint{
+					Address: addr.IP, TargetRef: addr.TargetRef,
+				})
+			}
+		}
+		result = append(result, se)
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFromEndpointSlices(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	sliceOf := func(name string, endpoints ...discoveryv1.Endpoint) discoveryv1.EndpointSlice {
+		return discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "svc"},
+			},
+			Endpoints: endpoints,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		slices []discoveryv1.EndpointSlice
+		want   []ServiceEndpoint
+	}{
+		{
+			name: "nil conditions are ready and serving",
+			slices: []discoveryv1.EndpointSlice{
+				sliceOf("svc-1", discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}),
+			},
+			want: []ServiceEndpoint{{Address: "10.0.0.1", Ready: true, Serving: true}},
+		},
+		{
+			name: "terminating but still serving",
+			slices: []discoveryv1.EndpointSlice{
+				sliceOf("svc-1", discoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.1"},
+					Conditions: discoveryv1.EndpointConditions{Ready: boolPtr(false), Serving: boolPtr(true), Terminating: boolPtr(true)},
+				}),
+			},
+			want: []ServiceEndpoint{{Address: "10.0.0.1", Ready: false, Serving: true, Terminating: true}},
+		},
+		{
+			name: "duplicated endpoints across slices",
+			slices: []discoveryv1.EndpointSlice{
+				sliceOf("svc-1", discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}),
+				sliceOf("svc-2", discoveryv1.Endpoint{Addresses: []string{"10.0.0.1"}}, discoveryv1.Endpoint{Addresses: []string{"10.0.0.2"}}),
+			},
+			want: []ServiceEndpoint{
+				{Address: "10.0.0.1", Ready: true, Serving: true},
+				{Address: "10.0.0.2", Ready: true, Serving: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fromEndpointSlices(discoveryv1.EndpointSliceList{Items: tt.slices})
+			if len(got) != 1 {
+				t.Fatalf("fromEndpointSlices() returned %d services, want 1", len(got))
+			}
+			if !reflect.DeepEqual(got[0].Endpoints, tt.want) {
+				t.Errorf("fromEndpointSlices() endpoints = %+v, want %+v", got[0].Endpoints, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	KeyK8sDynamicObjects = "kubernetes_dynamic_objects"
+)
+
+var _ detek.Collector = &K8sDynamicCollector{}
+
+// K8sDynamicCollector collects objects of arbitrary resources using a given group/version.
+// Resources not served by kubernetes will be skipped.
+type K8sDynamicCollector struct {
+	Resources []schema.GroupVersionResource
+}
+
+func (c *K8sDynamicCollector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_dynamic",
+			Description: fmt.Sprintf("collect objects of %d resources (if served) from kubernetes", len(c.Resources)),
+			Labels:      []string{"kubernetes", "dynamic", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sRestConfig:       {Type: detek.TypeOf(&rest.Config{})},
+			KeyK8sAPIResourceLists: {Type: detek.TypeOf([]metav1.APIResourceList{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sDynamicObjects: {Type: detek.TypeOf(map[schema.GroupVersionResource]unstructured.UnstructuredList{})},
+		},
+	}
+}
+
+func (c *K8sDynamicCollector) Do(dctx detek.DetekContext) error {
+	config, err := detek.Typing[*rest.Config](
+		dctx.Get(KeyK8sRestConfig, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes rest config: %w", err)
+	}
+	lists, err := detek.Typing[[]metav1.APIResourceList](
+		dctx.Get(KeyK8sAPIResourceLists, nil),
+	)
+	if err != nil {
+		return err
+	}
+	cli, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("fail to generate dynamic client: %w", err)
+	}
+
+	var errs = &multierror.Error{}
+	ctx := dctx.Context()
+
+	result := make(map[schema.GroupVersionResource]unstructured.UnstructuredList)
+	for _, gvr := range c.Resources {
+		if !IsServed(lists, gvr.GroupVersion().String(), gvr.Resource) {
+			continue
+		}
+		list, err := cli.Resource(gvr).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("fail to list %s: %w", gvr, err))
+			continue
+		}
+		result[gvr] = *list
+	}
+	errs = multierror.Append(errs,
+		dctx.Set(KeyK8sDynamicObjects, result),
+	)
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,117 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	KeyK8sMetricsV1Beta1NodeMetrics = "kubernetes_metrics_v1beta1_nodemetrics"
+	KeyK8sMetricsV1Beta1PodMetrics  = "kubernetes_metrics_v1beta1_podmetrics"
+)
+
+var (
+	metricsV1Beta1Nodes = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "nodes"}
+	metricsV1Beta1Pods  = schema.GroupVersionResource{Group: "metrics.k8s.io", Version: "v1beta1", Resource: "pods"}
+)
+
+// NodeMetrics is a resource usage of a node. (a subset of metrics.k8s.io/v1beta1 NodeMetrics)
+type NodeMetrics struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time     `json:"timestamp"`
+	Window            metav1.Duration `json:"window"`
+	Usage             v1.ResourceList `json:"usage"`
+}
+
+// PodMetrics is a resource usage of a pod. (a subset of metrics.k8s.io/v1beta1 PodMetrics)
+type PodMetrics struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Timestamp         metav1.Time        `json:"timestamp"`
+	Window            metav1.Duration    `json:"window"`
+	Containers        []ContainerMetrics `json:"containers"`
+}
+
+type ContainerMetrics struct {
+	Name  string          `json:"name"`
+	Usage v1.ResourceList `json:"usage"`
+}
+
+var _ detek.Collector = &K8sMetricsV1Beta1Collector{}
+
+// K8sMetricsV1Beta1Collector collects resource usages of nodes and pods from metrics.k8s.io (e.g, metrics-server).
+// If metrics.k8s.io is not served, nothing will be produced. (detectors should depend on them optionally)
+type K8sMetricsV1Beta1Collector struct{}
+
+func (*K8sMetricsV1Beta1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_metrics_v1beta1",
+			Description: "collect resource usages of nodes and pods from metrics.k8s.io (if served)",
+			Labels:      []string{"kubernetes", "metrics.k8s.io/v1beta1", "node", "pod", "metrics"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sRestConfig:       {Type: detek.TypeOf(&rest.Config{})},
+			KeyK8sAPIResourceLists: {Type: detek.TypeOf([]metav1.APIResourceList{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sMetricsV1Beta1NodeMetrics: {Type: detek.TypeOf([]NodeMetrics{})},
+			KeyK8sMetricsV1Beta1PodMetrics:  {Type: detek.TypeOf([]PodMetrics{})},
+		},
+	}
+}
+
+func (*K8sMetricsV1Beta1Collector) Do(dctx detek.DetekContext) error {
+	config, err := detek.Typing[*rest.Config](
+		dctx.Get(KeyK8sRestConfig, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes rest config: %w", err)
+	}
+	lists, err := detek.Typing[[]metav1.APIResourceList](
+		dctx.Get(KeyK8sAPIResourceLists, nil),
+	)
+	if err != nil {
+		return err
+	}
+	if !IsServed(lists, metricsV1Beta1Nodes.GroupVersion().String(), "") {
+		// metrics-server (or the other metrics api provider) is not installed
+		return nil
+	}
+	cli, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("fail to generate dynamic client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if list, err := cli.Resource(metricsV1Beta1Nodes).List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get node metrics from kubernetes: %w", err))
+	} else {
+		nodeMetrics := make([]NodeMetrics, len(list.Items))
+		for i, item := range list.Items {
+			errs = multierror.Append(errs, runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &nodeMetrics[i]))
+		}
+		errs = multierror.Append(errs, dctx.Set(KeyK8sMetricsV1Beta1NodeMetrics, nodeMetrics))
+	}
+
+	if list, err := cli.Resource(metricsV1Beta1Pods).List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get pod metrics from kubernetes: %w", err))
+	} else {
+		podMetrics := make([]PodMetrics, len(list.Items))
+		for i, item := range list.Items {
+			errs = multierror.Append(errs, runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &podMetrics[i]))
+		}
+		errs = multierror.Append(errs, dctx.Set(KeyK8sMetricsV1Beta1PodMetrics, podMetrics))
+	}
+
+	return errs.ErrorOrNil()
+}
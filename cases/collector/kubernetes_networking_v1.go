@@ -0,0 +1,64 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sNetworkingV1IngressList       = "kubernetes_networking_v1_ingresslist"
+	KeyK8sNetworkingV1NetworkPolicyList = "kubernetes_networking_v1_networkpolicylist"
+)
+
+var _ detek.Collector = &K8sNetworkingV1Collector{}
+
+type K8sNetworkingV1Collector struct{}
+
+func (*K8sNetworkingV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_networking_v1",
+			Description: "collect networking v1 resources from kubernetes",
+			Labels:      []string{"kubernetes", "networking/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sNetworkingV1IngressList:       {Type: detek.TypeOf(networkingv1.IngressList{})},
+			KeyK8sNetworkingV1NetworkPolicyList: {Type: detek.TypeOf(networkingv1.NetworkPolicyList{})},
+		},
+	}
+}
+
+func (*K8sNetworkingV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if ingressList, err := c.NetworkingV1().Ingresses("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get ingress list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sNetworkingV1IngressList, *ingressList))
+	}
+
+	if netpolList, err := c.NetworkingV1().NetworkPolicies("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get network policy list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sNetworkingV1NetworkPolicyList, *netpolList))
+	}
+
+	return errs.ErrorOrNil()
+}
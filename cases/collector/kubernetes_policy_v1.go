@@ -0,0 +1,49 @@
+package collector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sPolicyV1PodDisruptionBudgetList = "kubernetes_policy_v1_pod_disruption_budget_list"
+)
+
+var _ detek.Collector = &K8sPolicyV1Collector{}
+
+type K8sPolicyV1Collector struct{}
+
+func (*K8sPolicyV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_policy_v1",
+			Description: "collect policy v1 resources from kubernetes",
+			Labels:      []string{"kubernetes", "policy/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sPolicyV1PodDisruptionBudgetList: {Type: detek.TypeOf(v1.PodDisruptionBudgetList{})},
+		},
+	}
+}
+
+func (*K8sPolicyV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+
+	pdbList, err := c.PolicyV1().PodDisruptionBudgets("").List(dctx.Context(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("fail to get pod disruption budget list from kubernetes: %w", err)
+	}
+	return dctx.Set(KeyK8sPolicyV1PodDisruptionBudgetList, *pdbList)
+}
@@ -0,0 +1,89 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sRbacV1RoleList               = "kubernetes_rbac_v1_rolelist"
+	KeyK8sRbacV1ClusterRoleList        = "kubernetes_rbac_v1_clusterrolelist"
+	KeyK8sRbacV1RoleBindingList        = "kubernetes_rbac_v1_rolebindinglist"
+	KeyK8sRbacV1ClusterRoleBindingList = "kubernetes_rbac_v1_clusterrolebindinglist"
+	KeyK8sCoreV1ServiceAccountList     = "kubernetes_core_v1_serviceaccountlist"
+)
+
+var _ detek.Collector = &K8sRbacV1Collector{}
+
+// K8sRbacV1Collector collects rbac v1 resources, and ServiceAccounts which are subjects of them.
+type K8sRbacV1Collector struct{}
+
+func (*K8sRbacV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_rbac_v1",
+			Description: "collect rbac v1 resources and service accounts from kubernetes",
+			Labels:      []string{"kubernetes", "rbac/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sRbacV1RoleList:               {Type: detek.TypeOf(rbacv1.RoleList{})},
+			KeyK8sRbacV1ClusterRoleList:        {Type: detek.TypeOf(rbacv1.ClusterRoleList{})},
+			KeyK8sRbacV1RoleBindingList:        {Type: detek.TypeOf(rbacv1.RoleBindingList{})},
+			KeyK8sRbacV1ClusterRoleBindingList: {Type: detek.TypeOf(rbacv1.ClusterRoleBindingList{})},
+			KeyK8sCoreV1ServiceAccountList:     {Type: detek.TypeOf(v1.ServiceAccountList{})},
+		},
+	}
+}
+
+func (*K8sRbacV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if roleList, err := c.RbacV1().Roles("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get role list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sRbacV1RoleList, *roleList))
+	}
+
+	if clusterRoleList, err := c.RbacV1().ClusterRoles().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get cluster role list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sRbacV1ClusterRoleList, *clusterRoleList))
+	}
+
+	if roleBindingList, err := c.RbacV1().RoleBindings("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get role binding list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sRbacV1RoleBindingList, *roleBindingList))
+	}
+
+	if clusterRoleBindingList, err := c.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get cluster role binding list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sRbacV1ClusterRoleBindingList, *clusterRoleBindingList))
+	}
+
+	if saList, err := c.CoreV1().ServiceAccounts("").List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get service account list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs, dctx.Set(KeyK8sCoreV1ServiceAccountList, *saList))
+	}
+
+	return errs.ErrorOrNil()
+}
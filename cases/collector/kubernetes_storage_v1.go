@@ -0,0 +1,67 @@
+package collector
+
+import (
+	"fmt"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/kakao/detek/pkg/detek"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	KeyK8sStorageV1StorageClassList     = "kubernetes_storage_v1_storageclasslist"
+	KeyK8sStorageV1VolumeAttachmentList = "kubernetes_storage_v1_volumeattachmentlist"
+)
+
+var _ detek.Collector = &K8sStorageV1Collector{}
+
+type K8sStorageV1Collector struct{}
+
+func (*K8sStorageV1Collector) GetMeta() detek.CollectorInfo {
+	return detek.CollectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "kubernetes_storage_v1",
+			Description: "collect storage v1 resources from kubernetes",
+			Labels:      []string{"kubernetes", "storage/v1", "manifests"},
+		},
+		Required: detek.DependencyMeta{
+			KeyK8sClient: {Type: detek.TypeOf(&kubernetes.Clientset{})},
+		},
+		Producing: detek.DependencyMeta{
+			KeyK8sStorageV1StorageClassList:     {Type: detek.TypeOf(storagev1.StorageClassList{})},
+			KeyK8sStorageV1VolumeAttachmentList: {Type: detek.TypeOf(storagev1.VolumeAttachmentList{})},
+		},
+	}
+}
+
+func (*K8sStorageV1Collector) Do(dctx detek.DetekContext) error {
+	c, err := detek.Typing[*kubernetes.Clientset](
+		dctx.Get(KeyK8sClient, nil),
+	)
+	if err != nil {
+		return fmt.Errorf("fail to get kubernetes client: %w", err)
+	}
+	var errs = &multierror.Error{}
+
+	ctx := dctx.Context()
+
+	if scList, err := c.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get storage class list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sStorageV1StorageClassList, *scList),
+		)
+	}
+
+	if vaList, err := c.StorageV1().VolumeAttachments().List(ctx, metav1.ListOptions{}); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("fail to get volume attachment list from kubernetes: %w", err))
+	} else {
+		errs = multierror.Append(errs,
+			dctx.Set(KeyK8sStorageV1VolumeAttachmentList, *vaList),
+		)
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -1,7 +1,10 @@
 package cases
 
 import (
+	"time"
+
 	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/cases/detector"
 	"github.com/kakao/detek/pkg/detek"
 )
 
@@ -12,7 +15,33 @@ var CollectorSet map[string]CollectorSetInitiator = map[string]CollectorSetIniti
 		return []detek.Collector{
 			&collector.K8sClientCollector{KubeconfigPath: m[CONFIG_KUBECONFIG]},
 			&collector.K8sCoreV1Collector{},
-			&collector.K8sPolicyV1Beta1Collector{},
+			&collector.K8sDiscoveryV1Collector{},
+			&collector.K8sNetworkingV1Collector{},
+			&collector.K8sCoreV1TLSSecretCollector{},
+			&collector.K8sCoreV1StorageCollector{},
+			&collector.K8sStorageV1Collector{},
+			&collector.K8sRbacV1Collector{},
+			&collector.K8sCoreV1NamespaceCollector{},
+			&collector.K8sAppsV1Collector{},
+			&collector.K8sPolicyV1Collector{},
+			&collector.K8sAutoscalingV2Collector{},
+			&collector.K8sBatchV1Collector{},
+			&collector.K8sAdmissionRegistrationV1Collector{},
+			&collector.K8sAPIRegistrationV1Collector{},
+			&collector.K8sDiscoveryCollector{},
+			&collector.K8sMetricsV1Beta1Collector{},
+			&collector.K8sDynamicCollector{Resources: detector.APILifecycleResources()},
+			&collector.K8sCoreV1EventCollector{MaxAge: 6 * time.Hour},
+		}
+	},
+	UpgradeSet: func(m map[string]string) []detek.Collector {
+		return []detek.Collector{
+			&collector.K8sClientCollector{KubeconfigPath: m[CONFIG_KUBECONFIG]},
+			&collector.K8sCoreV1Collector{},
+			&collector.K8sAppsV1Collector{},
+			&collector.K8sPolicyV1Collector{},
+			&collector.K8sDiscoveryCollector{},
+			&collector.K8sDynamicCollector{Resources: detector.APILifecycleResources()},
 		}
 	},
 	// add more preset here
@@ -2,8 +2,11 @@ package cases
 
 const (
 	DefaultSet = "default"
+	// checks readiness of upgrading kubernetes to CONFIG_TARGET_VERSION
+	UpgradeSet = "upgrade"
 )
 
 const (
-	CONFIG_KUBECONFIG = "kubeconfig"
+	CONFIG_KUBECONFIG     = "kubeconfig"
+	CONFIG_TARGET_VERSION = "target_version"
 )
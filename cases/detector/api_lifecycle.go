@@ -0,0 +1,189 @@
+package detector
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	appsv1beta1 "k8s.io/api/apps/v1beta1"
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
+	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	flowcontrolv1beta1 "k8s.io/api/flowcontrol/v1beta1"
+	flowcontrolv1beta2 "k8s.io/api/flowcontrol/v1beta2"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	rbacv1beta1 "k8s.io/api/rbac/v1beta1"
+	schedulingv1beta1 "k8s.io/api/scheduling/v1beta1"
+	storagev1beta1 "k8s.io/api/storage/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// APILifecycle shows when a (group/version, resource) is deprecated and removed.
+type APILifecycle struct {
+	Resource     schema.GroupVersionResource
+	Kind         string
+	DeprecatedIn *utilversion.Version
+	RemovedIn    *utilversion.Version
+	// (optional) e.g, "networking.k8s.io/v1 Ingress"
+	Replacement string
+}
+
+// ReplacementGroupVersion returns the group/version of the replacement. (empty if no replacement)
+func (l APILifecycle) ReplacementGroupVersion() string {
+	gv, _, _ := strings.Cut(l.Replacement, " ")
+	return gv
+}
+
+type prereleaseLifecycle interface {
+	APILifecycleDeprecated() (major, minor int)
+	APILifecycleRemoved() (major, minor int)
+}
+
+type prereleaseReplacement interface {
+	APILifecycleReplacement() schema.GroupVersionKind
+}
+
+// lifecycleOf builds APILifecycle from prerelease-lifecycle methods generated in k8s.io/api.
+func lifecycleOf(group, version, resource string, obj prereleaseLifecycle) APILifecycle {
+	l := APILifecycle{
+		Resource:     schema.GroupVersionResource{Group: group, Version: version, Resource: resource},
+		Kind:         reflect.TypeOf(obj).Elem().Name(),
+		DeprecatedIn: majorMinor(obj.APILifecycleDeprecated()),
+		RemovedIn:    majorMinor(obj.APILifecycleRemoved()),
+	}
+	if r, ok := obj.(prereleaseReplacement); ok {
+		if gvk := r.APILifecycleReplacement(); !gvk.Empty() {
+			// some of generated replacements are pointing a list kind (e.g, "IngressClassList")
+			l.Replacement = fmt.Sprintf("%s %s", gvk.GroupVersion(), strings.TrimSuffix(gvk.Kind, "List"))
+		}
+	}
+	return l
+}
+
+func majorMinor(major, minor int) *utilversion.Version {
+	return utilversion.MustParseGeneric(fmt.Sprintf("%d.%d", major, minor))
+}
+
+// APILifecycles is a built-in table of deprecated (or removed) apis.
+// For more information, please refer https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var APILifecycles = []APILifecycle{
+	// extensions/v1beta1
+	lifecycleOf("extensions", "v1beta1", "ingresses", &extensionsv1beta1.Ingress{}),
+	lifecycleOf("extensions", "v1beta1", "daemonsets", &extensionsv1beta1.DaemonSet{}),
+	lifecycleOf("extensions", "v1beta1", "deployments", &extensionsv1beta1.Deployment{}),
+	lifecycleOf("extensions", "v1beta1", "replicasets", &extensionsv1beta1.ReplicaSet{}),
+	lifecycleOf("extensions", "v1beta1", "networkpolicies", &extensionsv1beta1.NetworkPolicy{}),
+	lifecycleOf("extensions", "v1beta1", "podsecuritypolicies", &extensionsv1beta1.PodSecurityPolicy{}),
+	// apps/v1beta1, apps/v1beta2
+	lifecycleOf("apps", "v1beta1", "deployments", &appsv1beta1.Deployment{}),
+	lifecycleOf("apps", "v1beta1", "statefulsets", &appsv1beta1.StatefulSet{}),
+	lifecycleOf("apps", "v1beta2", "deployments", &appsv1beta2.Deployment{}),
+	lifecycleOf("apps", "v1beta2", "statefulsets", &appsv1beta2.StatefulSet{}),
+	lifecycleOf("apps", "v1beta2", "daemonsets", &appsv1beta2.DaemonSet{}),
+	lifecycleOf("apps", "v1beta2", "replicasets", &appsv1beta2.ReplicaSet{}),
+	// admissionregistration.k8s.io/v1beta1
+	lifecycleOf("admissionregistration.k8s.io", "v1beta1", "mutatingwebhookconfigurations", &admissionregistrationv1beta1.MutatingWebhookConfiguration{}),
+	lifecycleOf("admissionregistration.k8s.io", "v1beta1", "validatingwebhookconfigurations", &admissionregistrationv1beta1.ValidatingWebhookConfiguration{}),
+	// apiextensions.k8s.io/v1beta1, apiregistration.k8s.io/v1beta1 (not in k8s.io/api)
+	{
+		Resource:     schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1beta1", Resource: "customresourcedefinitions"},
+		Kind:         "CustomResourceDefinition",
+		DeprecatedIn: majorMinor(1, 16),
+		RemovedIn:    majorMinor(1, 22),
+		Replacement:  "apiextensions.k8s.io/v1 CustomResourceDefinition",
+	},
+	{
+		Resource:     schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1beta1", Resource: "apiservices"},
+		Kind:         "APIService",
+		DeprecatedIn: majorMinor(1, 19),
+		RemovedIn:    majorMinor(1, 22),
+		Replacement:  "apiregistration.k8s.io/v1 APIService",
+	},
+	// autoscaling/v2beta1, autoscaling/v2beta2
+	lifecycleOf("autoscaling", "v2beta1", "horizontalpodautoscalers", &autoscalingv2beta1.HorizontalPodAutoscaler{}),
+	lifecycleOf("autoscaling", "v2beta2", "horizontalpodautoscalers", &autoscalingv2beta2.HorizontalPodAutoscaler{}),
+	// batch/v1beta1
+	lifecycleOf("batch", "v1beta1", "cronjobs", &batchv1beta1.CronJob{}),
+	// certificates.k8s.io/v1beta1
+	lifecycleOf("certificates.k8s.io", "v1beta1", "certificatesigningrequests", &certificatesv1beta1.CertificateSigningRequest{}),
+	// coordination.k8s.io/v1beta1
+	lifecycleOf("coordination.k8s.io", "v1beta1", "leases", &coordinationv1beta1.Lease{}),
+	// discovery.k8s.io/v1beta1
+	lifecycleOf("discovery.k8s.io", "v1beta1", "endpointslices", &discoveryv1beta1.EndpointSlice{}),
+	// flowcontrol.apiserver.k8s.io/v1beta1, flowcontrol.apiserver.k8s.io/v1beta2
+	lifecycleOf("flowcontrol.apiserver.k8s.io", "v1beta1", "flowschemas", &flowcontrolv1beta1.FlowSchema{}),
+	lifecycleOf("flowcontrol.apiserver.k8s.io", "v1beta1", "prioritylevelconfigurations", &flowcontrolv1beta1.PriorityLevelConfiguration{}),
+	lifecycleOf("flowcontrol.apiserver.k8s.io", "v1beta2", "flowschemas", &flowcontrolv1beta2.FlowSchema{}),
+	lifecycleOf("flowcontrol.apiserver.k8s.io", "v1beta2", "prioritylevelconfigurations", &flowcontrolv1beta2.PriorityLevelConfiguration{}),
+	// networking.k8s.io/v1beta1
+	lifecycleOf("networking.k8s.io", "v1beta1", "ingresses", &networkingv1beta1.Ingress{}),
+	lifecycleOf("networking.k8s.io", "v1beta1", "ingressclasses", &networkingv1beta1.IngressClass{}),
+	// node.k8s.io/v1beta1
+	lifecycleOf("node.k8s.io", "v1beta1", "runtimeclasses", &nodev1beta1.RuntimeClass{}),
+	// policy/v1beta1
+	lifecycleOf("policy", "v1beta1", "poddisruptionbudgets", &policyv1beta1.PodDisruptionBudget{}),
+	lifecycleOf("policy", "v1beta1", "podsecuritypolicies", &policyv1beta1.PodSecurityPolicy{}),
+	// rbac.authorization.k8s.io/v1beta1
+	lifecycleOf("rbac.authorization.k8s.io", "v1beta1", "roles", &rbacv1beta1.Role{}),
+	lifecycleOf("rbac.authorization.k8s.io", "v1beta1", "rolebindings", &rbacv1beta1.RoleBinding{}),
+	lifecycleOf("rbac.authorization.k8s.io", "v1beta1", "clusterroles", &rbacv1beta1.ClusterRole{}),
+	lifecycleOf("rbac.authorization.k8s.io", "v1beta1", "clusterrolebindings", &rbacv1beta1.ClusterRoleBinding{}),
+	// scheduling.k8s.io/v1beta1
+	lifecycleOf("scheduling.k8s.io", "v1beta1", "priorityclasses", &schedulingv1beta1.PriorityClass{}),
+	// storage.k8s.io/v1beta1
+	lifecycleOf("storage.k8s.io", "v1beta1", "csidrivers", &storagev1beta1.CSIDriver{}),
+	lifecycleOf("storage.k8s.io", "v1beta1", "csinodes", &storagev1beta1.CSINode{}),
+	lifecycleOf("storage.k8s.io", "v1beta1", "csistoragecapacities", &storagev1beta1.CSIStorageCapacity{}),
+	lifecycleOf("storage.k8s.io", "v1beta1", "storageclasses", &storagev1beta1.StorageClass{}),
+	lifecycleOf("storage.k8s.io", "v1beta1", "volumeattachments", &storagev1beta1.VolumeAttachment{}),
+}
+
+// APILifecycleResources returns every resource in APILifecycles.
+func APILifecycleResources() []schema.GroupVersionResource {
+	result := []schema.GroupVersionResource{}
+	for _, l := range APILifecycles {
+		result = append(result, l.Resource)
+	}
+	return result
+}
+
+// ParseKubernetesVersion parses versions like "v1.25.3", "1.25", "v1.25.3-gke.100" or "1.25+".
+func ParseKubernetesVersion(s string) (*utilversion.Version, error) {
+	v, err := utilversion.ParseGeneric(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid kubernetes version: %w", s, err)
+	}
+	return v, nil
+}
+
+// versionsOf returns the current version of the cluster, and the version to upgrade to.
+// (the target will be the current version, if targetVersion is empty)
+func versionsOf(serverVersion version.Info, targetVersion string) (current, target *utilversion.Version, err error) {
+	if current, err = ParseKubernetesVersion(serverVersion.GitVersion); err != nil {
+		return nil, nil, err
+	}
+	if targetVersion == "" {
+		return current, current, nil
+	}
+	if target, err = ParseKubernetesVersion(targetVersion); err != nil {
+		return nil, nil, err
+	}
+	return current, target, nil
+}
+
+func targetVersionText(targetVersion string) string {
+	if targetVersion == "" {
+		return "the current version"
+	}
+	return "v" + strings.TrimPrefix(targetVersion, "v")
+}
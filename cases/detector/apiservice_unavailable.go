@@ -0,0 +1,77 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ detek.Detector = &APIServiceUnavailable{}
+
+type APIServiceUnavailable struct{}
+
+// GetMeta implements detek.Detector
+func (*APIServiceUnavailable) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "apiservice_unavailable",
+			Description: "Finding aggregated APIServices with 'Available=False'",
+			Labels:      []string{"kubernetes", "apiservice", "aggregation"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAPIRegistrationV1APIServices: {Type: detek.TypeOf([]collector.APIService{})},
+		},
+		Level: detek.Fatal,
+		IfHappened: detek.Description{
+			Explanation: "Some of aggregated APIServices are unavailable. Their apis can not be used, and api discovery fails, " +
+				"which breaks clients (e.g, kubectl) and controllers (e.g, namespace deletion, garbage collection).",
+			Solution: "Check the Service (and pods) of those APIServices (e.g, metrics-server), or delete APIServices which are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*APIServiceUnavailable) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	apiServices, err := detek.Typing[[]collector.APIService](
+		ctx.Get(collector.KeyK8sAPIRegistrationV1APIServices, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name    string
+		Service string
+		Reason  string
+		Message string
+	}
+	problems := []Problem{}
+
+	for _, as := range apiServices {
+		for _, cond := range as.Status.Conditions {
+			if cond.Type != "Available" || cond.Status != metav1.ConditionFalse {
+				continue
+			}
+			service := "(local)"
+			if s := as.Spec.Service; s != nil {
+				service = s.Namespace + "/" + s.Name
+			}
+			problems = append(problems, Problem{
+				Name:    as.Name,
+				Service: service,
+				Reason:  cond.Reason,
+				Message: cond.Message,
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Unavailable APIServices",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated APIServices", Data: len(apiServices)},
+		},
+	}, nil
+}
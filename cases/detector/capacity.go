@@ -0,0 +1,79 @@
+package detector
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// podResourcesOf returns the effective requests and limits of the pod, as the scheduler does.
+// (max of the sum of containers and each init container, plus the pod overhead)
+func podResourcesOf(po v1.Pod) (requests, limits v1.ResourceList) {
+	requests, limits = v1.ResourceList{}, v1.ResourceList{}
+	for _, co := range po.Spec.Containers {
+		addResources(requests, co.Resources.Requests)
+		addResources(limits, co.Resources.Limits)
+	}
+	for _, co := range po.Spec.InitContainers {
+		maxResources(requests, co.Resources.Requests)
+		maxResources(limits, co.Resources.Limits)
+	}
+	addResources(requests, po.Spec.Overhead)
+	addResources(limits, po.Spec.Overhead)
+	return requests, limits
+}
+
+// hasLimit returns whether every container of the pod has a limit on a given resource.
+// a pod with any container without the limit can use the resource up to the allocatable of its node.
+func hasLimit(po v1.Pod, res v1.ResourceName) bool {
+	for _, containers := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for _, co := range containers {
+			if _, ok := co.Resources.Limits[res]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func addResources(dst, src v1.ResourceList) {
+	for name, q := range src {
+		cur := dst[name]
+		cur.Add(q)
+		dst[name] = cur
+	}
+}
+
+func maxResources(dst, src v1.ResourceList) {
+	for name, q := range src {
+		if cur, ok := dst[name]; !ok || q.Cmp(cur) > 0 {
+			dst[name] = q.DeepCopy()
+		}
+	}
+}
+
+// isActivePod returns whether the pod occupies resources of a node (scheduled, and not finished).
+func isActivePod(po v1.Pod) bool {
+	return po.Spec.NodeName != "" && po.Status.Phase != v1.PodSucceeded && po.Status.Phase != v1.PodFailed
+}
+
+// isUnscheduledPod returns whether the pod is waiting to be scheduled.
+func isUnscheduledPod(po v1.Pod) bool {
+	return po.Spec.NodeName == "" && po.Status.Phase == v1.PodPending && po.DeletionTimestamp == nil
+}
+
+// percentOf returns "used / total" as a text. (e.g, "85%")
+func percentOf(used, total resource.Quantity) string {
+	if total.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", ratioOf(used, total)*100)
+}
+
+func ratioOf(used, total resource.Quantity) float64 {
+	if total.IsZero() {
+		return 0
+	}
+	return float64(used.MilliValue()) / float64(total.MilliValue())
+}
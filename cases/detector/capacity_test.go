@@ -0,0 +1,177 @@
+package detector
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func resourceListOf(cpu, memory string) v1.ResourceList {
+	list := v1.ResourceList{}
+	if cpu != "" {
+		list[v1.ResourceCPU] = resource.MustParse(cpu)
+	}
+	if memory != "" {
+		list[v1.ResourceMemory] = resource.MustParse(memory)
+	}
+	return list
+}
+
+func containerOf(requests, limits v1.ResourceList) v1.Container {
+	return v1.Container{Resources: v1.ResourceRequirements{Requests: requests, Limits: limits}}
+}
+
+// equalResources compares quantities of given resource lists. (missing resources are treated as zero)
+func equalResources(a, b v1.ResourceList) bool {
+	for _, list := range []v1.ResourceList{a, b} {
+		for name := range list {
+			if qa, qb := a[name], b[name]; qa.Cmp(qb) != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestPodResourcesOf(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         v1.PodSpec
+		wantRequests v1.ResourceList
+		wantLimits   v1.ResourceList
+	}{
+		{
+			name: "sum of containers",
+			spec: v1.PodSpec{Containers: []v1.Container{
+				containerOf(resourceListOf("100m", "128Mi"), resourceListOf("200m", "256Mi")),
+				containerOf(resourceListOf("50m", "64Mi"), resourceListOf("", "64Mi")),
+			}},
+			wantRequests: resourceListOf("150m", "192Mi"),
+			wantLimits:   resourceListOf("200m", "320Mi"),
+		},
+		{
+			name: "init container larger than containers",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					containerOf(resourceListOf("1", "64Mi"), resourceListOf("2", "64Mi")),
+				},
+				Containers: []v1.Container{
+					containerOf(resourceListOf("100m", "128Mi"), resourceListOf("200m", "256Mi")),
+					containerOf(resourceListOf("100m", "128Mi"), resourceListOf("200m", "256Mi")),
+				},
+			},
+			wantRequests: resourceListOf("1", "256Mi"),
+			wantLimits:   resourceListOf("2", "512Mi"),
+		},
+		{
+			name: "max of init containers",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					containerOf(resourceListOf("500m", ""), nil),
+					containerOf(resourceListOf("300m", "1Gi"), nil),
+				},
+				Containers: []v1.Container{
+					containerOf(resourceListOf("100m", "128Mi"), nil),
+				},
+			},
+			wantRequests: resourceListOf("500m", "1Gi"),
+			wantLimits:   resourceListOf("", ""),
+		},
+		{
+			name: "with overhead",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					containerOf(resourceListOf("1", ""), resourceListOf("1", "")),
+				},
+				Containers: []v1.Container{
+					containerOf(resourceListOf("100m", "128Mi"), resourceListOf("200m", "256Mi")),
+				},
+				Overhead: resourceListOf("250m", "120Mi"),
+			},
+			wantRequests: resourceListOf("1250m", "248Mi"),
+			wantLimits:   resourceListOf("1250m", "376Mi"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests, limits := podResourcesOf(v1.Pod{Spec: tt.spec})
+			if !equalResources(requests, tt.wantRequests) {
+				t.Errorf("podResourcesOf() requests = %v, want %v", requests, tt.wantRequests)
+			}
+			if !equalResources(limits, tt.wantLimits) {
+				t.Errorf("podResourcesOf() limits = %v, want %v", limits, tt.wantLimits)
+			}
+		})
+	}
+}
+
+func TestHasLimit(t *testing.T) {
+	tests := []struct {
+		name string
+		spec v1.PodSpec
+		want bool
+	}{
+		{
+			name: "every container",
+			spec: v1.PodSpec{Containers: []v1.Container{
+				containerOf(nil, resourceListOf("", "128Mi")),
+				containerOf(nil, resourceListOf("", "128Mi")),
+			}},
+			want: true,
+		},
+		{
+			name: "one of containers without a limit",
+			spec: v1.PodSpec{Containers: []v1.Container{
+				containerOf(nil, resourceListOf("", "128Mi")),
+				containerOf(nil, resourceListOf("100m", "")),
+			}},
+			want: false,
+		},
+		{
+			name: "init container without a limit",
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{containerOf(nil, nil)},
+				Containers:     []v1.Container{containerOf(nil, resourceListOf("", "128Mi"))},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasLimit(v1.Pod{Spec: tt.spec}, v1.ResourceMemory); got != tt.want {
+				t.Errorf("hasLimit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFits(t *testing.T) {
+	node := &nodeCommitment{
+		Allocatable: resourceListOf("4", "8Gi"),
+		Requests:    resourceListOf("3", "4Gi"),
+	}
+	tests := []struct {
+		name     string
+		requests v1.ResourceList
+		want     bool
+	}{
+		{name: "fits", requests: resourceListOf("500m", "1Gi"), want: true},
+		{name: "exactly the free resources", requests: resourceListOf("1", "4Gi"), want: true},
+		{name: "not enough cpu", requests: resourceListOf("1500m", "1Gi"), want: false},
+		{name: "not enough memory", requests: resourceListOf("100m", "5Gi"), want: false},
+		{name: "no requests", requests: v1.ResourceList{}, want: true},
+		{
+			name:     "resource not allocatable on the node",
+			requests: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fits(node, tt.requests); got != tt.want {
+				t.Errorf("fits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +0,0 @@
-package detector
-
-const (
-	K8S_VERSION_1_21 = 1.21
-	K8S_VERSION_1_25 = 1.25
-)
\ No newline at end of file
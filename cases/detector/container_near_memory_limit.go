@@ -0,0 +1,111 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &ContainerNearMemoryLimit{}
+
+type ContainerNearMemoryLimit struct {
+	// containers using more than this ratio of memory limits will be reported. (default: 0.9)
+	UsageRatio float64
+}
+
+func (d *ContainerNearMemoryLimit) usageRatio() float64 {
+	if d.UsageRatio == 0 {
+		return 0.9
+	}
+	return d.UsageRatio
+}
+
+// GetMeta implements detek.Detector
+func (d *ContainerNearMemoryLimit) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "container_near_memory_limit",
+			Description: fmt.Sprintf("Finding containers using more than %.0f%% of their memory limits", d.usageRatio()*100),
+			Labels:      []string{"kubernetes", "pod", "metrics", "memory"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:            {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sMetricsV1Beta1PodMetrics: {Type: detek.TypeOf([]collector.PodMetrics{}), IsOptional: true},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of containers are running near their memory limits, and will be OOMKilled if the usage grows a little more.",
+			Solution:    "Raise memory limits of those containers, or check whether they are leaking memory.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *ContainerNearMemoryLimit) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podMetrics, ok, err := loadPodMetrics(ctx)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return metricsUnavailable(), nil
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Workload  string
+		Container string
+		Limit     string
+		Usage     string
+		Ratio     string
+	}
+	problems := []Problem{}
+	evaluated := 0
+
+	for _, po := range podList.Items {
+		m, ok := podMetrics[po.Namespace+"/"+po.Name]
+		if !ok {
+			continue
+		}
+		usages := map[string]v1.ResourceList{}
+		for _, co := range m.Containers {
+			usages[co.Name] = co.Usage
+		}
+		for _, co := range po.Spec.Containers {
+			limit, ok := co.Resources.Limits[v1.ResourceMemory]
+			usage, found := usages[co.Name][v1.ResourceMemory]
+			if !ok || !found || limit.IsZero() {
+				continue
+			}
+			evaluated++
+			if ratioOf(usage, limit) >= d.usageRatio() {
+				problems = append(problems, Problem{
+					Namespace: po.Namespace,
+					Name:      po.Name,
+					Workload:  workloadOf(po),
+					Container: co.Name,
+					Limit:     limit.String(),
+					Usage:     usage.String(),
+					Ratio:     percentOf(usage, limit),
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Containers near their memory limits",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated containers (with memory limits)", Data: evaluated},
+		},
+	}, nil
+}
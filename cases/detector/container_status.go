@@ -0,0 +1,78 @@
+package detector
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// containerProblem is a common problem format of detectors inspecting container statuses.
+type containerProblem struct {
+	Namespace    string
+	Name         string
+	Container    string
+	Workload     string
+	Reason       string
+	RestartCount int32
+	LastExitCode *int32 `json:",omitempty"`
+	LastExitedAt string `json:",omitempty"`
+	Message      string `json:",omitempty"`
+}
+
+func newContainerProblem(po v1.Pod, cs v1.ContainerStatus, reason string) containerProblem {
+	p := containerProblem{
+		Namespace:    po.Namespace,
+		Name:         po.Name,
+		Container:    cs.Name,
+		Workload:     workloadOf(po),
+		Reason:       reason,
+		RestartCount: cs.RestartCount,
+	}
+	if term := lastTerminated(cs); term != nil {
+		exitCode := term.ExitCode
+		p.LastExitCode = &exitCode
+		if !term.FinishedAt.IsZero() {
+			p.LastExitedAt = term.FinishedAt.UTC().Format(time.RFC3339)
+		}
+		p.Message = term.Message
+	}
+	if waiting := cs.State.Waiting; waiting != nil && waiting.Message != "" {
+		p.Message = waiting.Message
+	}
+	return p
+}
+
+// containerStatusesOf returns statuses of init containers and containers.
+func containerStatusesOf(po v1.Pod) []v1.ContainerStatus {
+	cs := []v1.ContainerStatus{}
+	cs = append(cs, po.Status.InitContainerStatuses...)
+	cs = append(cs, po.Status.ContainerStatuses...)
+	return cs
+}
+
+// lastTerminated returns the latest termination of a container. (nil if never terminated)
+func lastTerminated(cs v1.ContainerStatus) *v1.ContainerStateTerminated {
+	if term := cs.State.Terminated; term != nil {
+		return term
+	}
+	return cs.LastTerminationState.Terminated
+}
+
+// workloadOf returns the workload managing a given pod. (e.g, "Deployment/nginx")
+func workloadOf(po v1.Pod) string {
+	owners := []string{}
+	for _, o := range po.OwnerReferences {
+		kind, name := o.Kind, o.Name
+		// ReplicaSets created by a Deployment are named "<deployment>-<pod-template-hash>"
+		if hash, ok := po.Labels["pod-template-hash"]; ok && kind == "ReplicaSet" && strings.HasSuffix(name, "-"+hash) {
+			kind, name = "Deployment", strings.TrimSuffix(name, "-"+hash)
+		}
+		owners = append(owners, fmt.Sprintf("%s/%s", kind, name))
+	}
+	if len(owners) == 0 {
+		return "(none)"
+	}
+	return strings.Join(owners, ",")
+}
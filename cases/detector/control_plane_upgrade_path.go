@@ -0,0 +1,79 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+var _ detek.Detector = &ControlPlaneUpgradePath{}
+
+type ControlPlaneUpgradePath struct {
+	// Kubernetes version to upgrade the control plane to. (e.g, "1.27")
+	TargetVersion string
+}
+
+// GetMeta implements detek.Detector
+func (d *ControlPlaneUpgradePath) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "control_plane_upgrade_path",
+			Description: fmt.Sprintf("Checking the control plane can be upgraded to %s directly", targetVersionText(d.TargetVersion)),
+			Labels:      []string{"kubernetes", "version", "upgrade"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sVersion: {Type: detek.TypeOf(version.Info{})},
+		},
+		Level: detek.Fatal,
+		IfHappened: detek.Description{
+			Explanation: "The control plane can not be upgraded to the target version directly. Skipping minor versions (or downgrading) is not supported.",
+			Solution: "Upgrade the control plane one minor version at a time. " +
+				"For more information, please refer https://kubernetes.io/releases/version-skew-policy/#kube-apiserver",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *ControlPlaneUpgradePath) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serverVersion, err := detek.Typing[version.Info](
+		ctx.Get(collector.KeyK8sVersion, nil))
+	if err != nil {
+		return nil, err
+	}
+	current, target, err := versionsOf(serverVersion, d.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Current string
+		Target  string
+		Reason  string
+	}
+	problems := []Problem{}
+	addProblem := func(reason string) {
+		problems = append(problems, Problem{
+			Current: "v" + current.String(),
+			Target:  "v" + target.String(),
+			Reason:  reason,
+		})
+	}
+	switch {
+	case target.Major() != current.Major():
+		addProblem("major version is different from the current control plane")
+	case target.Minor() < current.Minor():
+		addProblem("downgrading the control plane is not supported")
+	case target.Minor() > current.Minor()+1:
+		addProblem(fmt.Sprintf("%d minor versions should be upgraded one by one", target.Minor()-current.Minor()))
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Unsupported upgrade path",
+			Data:        problems,
+		},
+	}, nil
+}
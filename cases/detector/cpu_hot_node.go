@@ -0,0 +1,103 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &CPUHotNode{}
+
+type CPUHotNode struct {
+	// nodes using more than this ratio of allocatable cpu will be reported. (default: 0.9)
+	UsageRatio float64
+	Threshold  RatioThreshold
+}
+
+func (d *CPUHotNode) usageRatio() float64 {
+	if d.UsageRatio == 0 {
+		return 0.9
+	}
+	return d.UsageRatio
+}
+
+// GetMeta implements detek.Detector
+func (d *CPUHotNode) GetMeta() detek.DetectorInfo {
+	ifHappened := detek.Description{
+		Explanation: fmt.Sprintf("Some of nodes are using more than %.0f%% of their allocatable cpu. "+
+			"Pods on those nodes are throttled, and may be slow to respond (or fail their probes).", d.usageRatio()*100),
+		Solution: "Check which pods are using cpu more than requested on those nodes, and raise their requests (or limits) to spread them, or add nodes.",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "cpu_hot_node",
+			Description: fmt.Sprintf("Finding nodes using more than %.0f%% of allocatable cpu", d.usageRatio()*100),
+			Labels:      []string{"kubernetes", "node", "metrics", "cpu"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList:            {Type: detek.TypeOf(v1.NodeList{})},
+			collector.KeyK8sMetricsV1Beta1NodeMetrics: {Type: detek.TypeOf([]collector.NodeMetrics{}), IsOptional: true},
+		},
+		Level:            detek.Error,
+		IfHappened:       ifHappened,
+		LevelDescription: d.Threshold.Describe(ifHappened),
+	}
+}
+
+// Do implements detek.Detector
+func (d *CPUHotNode) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+	nodeMetrics, err := detek.Typing[[]collector.NodeMetrics](
+		ctx.Get(collector.KeyK8sMetricsV1Beta1NodeMetrics, nil))
+	if detek.IsKeyNotFound(err) {
+		return metricsUnavailable(), nil
+	} else if err != nil {
+		return nil, err
+	}
+	usages := map[string]v1.ResourceList{}
+	for _, m := range nodeMetrics {
+		usages[m.Name] = m.Usage
+	}
+
+	type Problem struct {
+		Name        string
+		Allocatable string
+		Usage       string
+		Ratio       string
+	}
+	problems := []Problem{}
+
+	for _, no := range nodeList.Items {
+		allocatable := no.Status.Allocatable[v1.ResourceCPU]
+		usage, ok := usages[no.Name][v1.ResourceCPU]
+		if !ok || allocatable.IsZero() {
+			continue
+		}
+		if ratioOf(usage, allocatable) >= d.usageRatio() {
+			problems = append(problems, Problem{
+				Name:        no.Name,
+				Allocatable: allocatable.String(),
+				Usage:       usage.String(),
+				Ratio:       percentOf(usage, allocatable),
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: d.Threshold.LevelOf(len(problems), len(nodeList.Items)),
+		Problem: detek.JSONableData{
+			Description: "Nodes hot on cpu",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Nodes", Data: len(nodeList.Items)},
+		},
+	}, nil
+}
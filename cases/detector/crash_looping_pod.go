@@ -0,0 +1,59 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &CrashLoopingPod{}
+
+type CrashLoopingPod struct{}
+
+// GetMeta implements detek.Detector
+func (*CrashLoopingPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "crash_looping_pod",
+			Description: "Finding containers in a 'CrashLoopBackOff' status",
+			Labels:      []string{"kubernetes", "pod", "container"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of containers keep crashing, and Kubernetes is waiting before restarting them again.",
+			Solution:    "Check logs of the previous run (`kubectl logs <pod> -c <container> --previous`) and the last exit code.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*CrashLoopingPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	problems := []containerProblem{}
+	for _, po := range podList.Items {
+		for _, cs := range containerStatusesOf(po) {
+			if waiting := cs.State.Waiting; waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+				problems = append(problems, newContainerProblem(po, cs, waiting.Reason))
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Crash looping containers",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
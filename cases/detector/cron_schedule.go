@@ -0,0 +1,205 @@
+package detector
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed schedule of a CronJob. (standard 5 fields, with macros like "@daily" or "@every 1h")
+type cronSchedule struct {
+	minute, hour, dom, month, dow map[int]bool
+	// if both day of month and day of week are restricted, either of them matches. (same as cron)
+	domStar, dowStar bool
+	location         *time.Location
+	// "@every <duration>" runs at a fixed interval, instead of the fields above
+	every time.Duration
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	cronMonthNames = map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}
+	cronDayNames   = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+// parseCronSchedule parses a schedule of a CronJob, in a given time zone. (UTC if empty)
+func parseCronSchedule(spec, timeZone string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	// "CRON_TZ=<zone> <schedule>" (or "TZ=") is not officially supported, but accepted by the controller
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		tz, rest, _ := strings.Cut(spec, " ")
+		_, timeZone, _ = strings.Cut(tz, "=")
+		spec = strings.TrimSpace(rest)
+	}
+	location := time.UTC
+	if timeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(timeZone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", timeZone, err)
+		}
+	}
+	if strings.HasPrefix(spec, "@every ") {
+		interval := strings.TrimPrefix(spec, "@every ")
+		every, err := time.ParseDuration(strings.TrimSpace(interval))
+		if err != nil || every <= 0 {
+			return nil, fmt.Errorf("invalid interval %q in %q", interval, spec)
+		}
+		// rounded to seconds, at least a second (same as the controller)
+		every = every.Truncate(time.Second)
+		if every < time.Second {
+			every = time.Second
+		}
+		return &cronSchedule{location: location, every: every}, nil
+	}
+	if macro, ok := cronMacros[spec]; ok {
+		spec = macro
+	}
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields, but got %d in %q", len(fields), spec)
+	}
+	s := &cronSchedule{location: location, domStar: fields[2] == "*" || fields[2] == "?", dowStar: fields[4] == "*" || fields[4] == "?"}
+	var err error
+	if s.minute, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseCronField(fields[2], 1, 31, nil); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseCronField(fields[3], 1, 12, cronMonthNames); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseCronField(fields[4], 0, 7, cronDayNames); err != nil {
+		return nil, err
+	}
+	// 7 is also Sunday
+	if s.dow[7] {
+		s.dow[0] = true
+	}
+	return s, nil
+}
+
+// parseCronField parses a field like "*", "*/5", "1,2,3", "1-10/2" or "MON-FRI". (reversed ranges like "5-1" are invalid)
+func parseCronField(field string, min, max int, names map[string]int) (map[int]bool, error) {
+	value := func(s string) (int, error) {
+		if v, ok := names[strings.ToUpper(s)]; ok {
+			return v, nil
+		}
+		v, err := strconv.Atoi(s)
+		if err != nil || v < min || v > max {
+			return 0, fmt.Errorf("invalid value %q in %q (should be %d-%d)", s, field, min, max)
+		}
+		return v, nil
+	}
+	result := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		rng, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepText); err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q in %q", stepText, field)
+			}
+		}
+		from, to := min, max
+		switch {
+		case rng == "*" || rng == "?":
+		case strings.Contains(rng, "-"):
+			a, b, _ := strings.Cut(rng, "-")
+			var err error
+			if from, err = value(a); err != nil {
+				return nil, err
+			}
+			if to, err = value(b); err != nil {
+				return nil, err
+			}
+			if from > to {
+				return nil, fmt.Errorf("invalid range %q in %q", rng, field)
+			}
+		default:
+			v, err := value(rng)
+			if err != nil {
+				return nil, err
+			}
+			from, to = v, v
+			if hasStep {
+				// "5/10" means "5-<max>/10"
+				to = max
+			}
+		}
+		for v := from; v <= to; v += step {
+			result[v] = true
+		}
+	}
+	return result, nil
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	if !s.month[int(t.Month())] {
+		return false
+	}
+	dom, dow := s.dom[t.Day()], s.dow[int(t.Weekday())]
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// next returns the first scheduled time after t. (zero time if not found within 5 years, e.g, "0 0 30 2 *")
+func (s *cronSchedule) next(t time.Time) time.Time {
+	if s.every != 0 {
+		return t.In(s.location).Truncate(time.Second).Add(s.every)
+	}
+	t = t.In(s.location).Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !s.matchesDay(t) {
+			// the next midnight may not exist on a DST transition, and normalized to the previous day
+			next := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.location)
+			for !next.After(t) {
+				next = next.Add(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if !s.hour[t.Hour()] {
+			// adding minutes instead of time.Date, which goes back on a DST transition (e.g, 02:00 -> 01:00)
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if !s.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// countBetween returns the number of scheduled times in (from, to], up to max.
+func (s *cronSchedule) countBetween(from, to time.Time, max int) int {
+	count := 0
+	for t := s.next(from); !t.IsZero() && !t.After(to) && count < max; t = s.next(t) {
+		count++
+	}
+	return count
+}
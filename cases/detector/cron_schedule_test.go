@@ -0,0 +1,261 @@
+package detector
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("fail to load location %q: %v", name, err)
+	}
+	return loc
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	seoul := mustLoadLocation(t, "Asia/Seoul")
+
+	type args struct {
+		spec     string
+		timeZone string
+		from     time.Time
+	}
+	tests := []struct {
+		name string
+		args args
+		// expected next scheduled times, in order (empty if never)
+		want []time.Time
+	}{
+		{
+			name: "macro @daily",
+			args: args{spec: "@daily", from: time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "macro @hourly",
+			args: args{spec: "@hourly", from: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "step from a wildcard",
+			args: args{spec: "*/15 * * * *", from: time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "step from a value",
+			args: args{spec: "5/10 * * * *", from: time.Date(2024, 1, 1, 10, 50, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 10, 55, 0, 0, time.UTC),
+				time.Date(2024, 1, 1, 11, 5, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "month names",
+			args: args{spec: "0 0 1 mar,Jun *", from: time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// 2024-01-05 is a Friday
+			name: "weekday names",
+			args: args{spec: "0 2 * * MON-FRI", from: time.Date(2024, 1, 5, 3, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 8, 2, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 9, 2, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weekday 7 is Sunday",
+			args: args{spec: "0 0 * * 7", from: time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			// either the 15th, or a Monday (2024-01-08 is a Monday)
+			name: "day of month or day of week, if both are restricted",
+			args: args{spec: "0 0 15 * MON", from: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "day of month only, if day of week is a wildcard",
+			args: args{spec: "0 0 15 * *", from: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "CRON_TZ prefix",
+			args: args{spec: "CRON_TZ=Asia/Seoul 0 3 * * *", from: time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 3, 0, 0, 0, seoul),
+				time.Date(2024, 1, 2, 3, 0, 0, 0, seoul),
+			},
+		},
+		{
+			name: "spec.timeZone",
+			args: args{spec: "0 3 * * *", timeZone: "Asia/Seoul", from: time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 3, 0, 0, 0, seoul),
+				time.Date(2024, 1, 2, 3, 0, 0, 0, seoul),
+			},
+		},
+		{
+			// 02:00-03:00 does not exist on 2024-03-10 in New York, so the run is skipped (same as the CronJob controller)
+			name: "skipped time on a DST transition",
+			args: args{spec: "30 2 * * *", timeZone: "America/New_York", from: time.Date(2024, 3, 9, 3, 0, 0, 0, newYork)},
+			want: []time.Time{
+				time.Date(2024, 3, 11, 2, 30, 0, 0, newYork),
+				time.Date(2024, 3, 12, 2, 30, 0, 0, newYork),
+			},
+		},
+		{
+			name: "hourly across a DST transition",
+			args: args{spec: "0 * * * *", timeZone: "America/New_York", from: time.Date(2024, 3, 10, 0, 30, 0, 0, newYork)},
+			want: []time.Time{
+				time.Date(2024, 3, 10, 1, 0, 0, 0, newYork),
+				// 02:00 EST is 03:00 EDT
+				time.Date(2024, 3, 10, 3, 0, 0, 0, newYork),
+				time.Date(2024, 3, 10, 4, 0, 0, 0, newYork),
+			},
+		},
+		{
+			name: "@every",
+			args: args{spec: "@every 90m", from: time.Date(2024, 1, 1, 10, 30, 15, 500, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 12, 0, 15, 0, time.UTC),
+				time.Date(2024, 1, 1, 13, 30, 15, 0, time.UTC),
+			},
+		},
+		{
+			name: "@every shorter than a second",
+			args: args{spec: "@every 10ms", from: time.Date(2024, 1, 1, 10, 30, 15, 0, time.UTC)},
+			want: []time.Time{
+				time.Date(2024, 1, 1, 10, 30, 16, 0, time.UTC),
+			},
+		},
+		{
+			name: "impossible date",
+			args: args{spec: "0 0 30 2 *", from: time.Date(2023, 12, 31, 12, 0, 0, 0, time.UTC)},
+			want: []time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseCronSchedule(tt.args.spec, tt.args.timeZone)
+			if err != nil {
+				t.Fatalf("parseCronSchedule() error = %v", err)
+			}
+			from := tt.args.from
+			for i, want := range tt.want {
+				got := s.next(from)
+				if !got.Equal(want) {
+					t.Fatalf("next() #%d = %v, want %v", i, got, want)
+				}
+				from = got
+			}
+			if len(tt.want) == 0 {
+				if got := s.next(from); !got.IsZero() {
+					t.Errorf("next() = %v, want zero time", got)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		timeZone string
+	}{
+		{name: "too few fields", spec: "0 0 * *"},
+		{name: "out of range", spec: "61 * * * *"},
+		{name: "unknown name", spec: "0 0 * * FOO"},
+		{name: "invalid step", spec: "*/0 * * * *"},
+		{name: "reversed range", spec: "5-1 * * * *"},
+		{name: "reversed range of names", spec: "0 0 * * FRI-MON"},
+		{name: "invalid @every", spec: "@every often"},
+		{name: "negative @every", spec: "@every -1h"},
+		{name: "unknown time zone", spec: "0 0 * * *", timeZone: "Mars/Olympus"},
+		{name: "unknown CRON_TZ", spec: "CRON_TZ=Mars/Olympus 0 0 * * *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseCronSchedule(tt.spec, tt.timeZone); err == nil {
+				t.Errorf("parseCronSchedule(%q, %q) expected an error", tt.spec, tt.timeZone)
+			}
+		})
+	}
+}
+
+func TestCronScheduleCountBetween(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		spec     string
+		from, to time.Time
+		max      int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "counts scheduled times in (from, to]",
+			args: args{spec: "@daily", from: from, to: from.Add(72 * time.Hour), max: 10},
+			want: 3,
+		},
+		{
+			name: "capped by max",
+			args: args{spec: "* * * * *", from: from, to: from.AddDate(1, 0, 0), max: 3},
+			want: 3,
+		},
+		{
+			name: "nothing in the range",
+			args: args{spec: "0 0 1 1 *", from: from, to: from.AddDate(0, 6, 0), max: 3},
+			want: 0,
+		},
+		{
+			name: "@every",
+			args: args{spec: "@every 1h", from: from, to: from.Add(24 * time.Hour), max: 100},
+			want: 24,
+		},
+		{
+			name: "impossible date",
+			args: args{spec: "0 0 30 2 *", from: from, to: from.AddDate(10, 0, 0), max: 3},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := parseCronSchedule(tt.args.spec, "")
+			if err != nil {
+				t.Fatalf("parseCronSchedule() error = %v", err)
+			}
+			if got := s.countBetween(tt.args.from, tt.args.to, tt.args.max); got != tt.want {
+				t.Errorf("countBetween() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ detek.Detector = &CronJobWithoutRecentSuccess{}
+
+type CronJobWithoutRecentSuccess struct {
+	// CronJobs without a successful run within this number of schedule intervals will be reported. (default: 2)
+	MissedSchedules int
+}
+
+func (d *CronJobWithoutRecentSuccess) missedSchedules() int {
+	if d.MissedSchedules == 0 {
+		return 2
+	}
+	return d.MissedSchedules
+}
+
+// GetMeta implements detek.Detector
+func (d *CronJobWithoutRecentSuccess) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "cronjob_without_recent_success",
+			Description: fmt.Sprintf("Finding CronJobs without a successful run within %d schedule intervals", d.missedSchedules()),
+			Labels:      []string{"kubernetes", "batch", "cronjob"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sBatchV1CronJobList: {Type: detek.TypeOf(batchv1.CronJobList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of CronJobs have not succeeded for a while. Their jobs are failing, running too long, or not scheduled at all (e.g, missed their startingDeadlineSeconds).",
+			Solution:    "Check recent jobs of those CronJobs (`kubectl get jobs -n <namespace>`) and their pods' logs, or events of the CronJob if no job is created.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *CronJobWithoutRecentSuccess) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	cronJobList, err := detek.Typing[batchv1.CronJobList](
+		ctx.Get(collector.KeyK8sBatchV1CronJobList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace          string
+		Name               string
+		Schedule           string
+		LastSuccessfulTime string
+		LastScheduleTime   string
+		MissedSchedules    string
+		ActiveJobs         int
+	}
+	problems := []Problem{}
+	type InvalidSchedule struct {
+		Namespace, Name, Schedule, Error string
+	}
+	invalids := []InvalidSchedule{}
+
+	now := time.Now()
+	for _, cj := range cronJobList.Items {
+		if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+			continue
+		}
+		timeZone := ""
+		if cj.Spec.TimeZone != nil {
+			timeZone = *cj.Spec.TimeZone
+		}
+		schedule, err := parseCronSchedule(cj.Spec.Schedule, timeZone)
+		if err != nil {
+			invalids = append(invalids, InvalidSchedule{cj.Namespace, cj.Name, cj.Spec.Schedule, err.Error()})
+			continue
+		}
+		// never succeeded yet, count from the creation
+		since, lastSuccess := cj.CreationTimestamp.Time, "(never)"
+		if t := cj.Status.LastSuccessfulTime; t != nil {
+			since, lastSuccess = t.Time, t.Time.Format(time.RFC3339)
+		}
+		missed := schedule.countBetween(since, now, d.missedSchedules()+1)
+		// the last one may be still running
+		if missed <= d.missedSchedules() {
+			continue
+		}
+		lastSchedule := "(never)"
+		if t := cj.Status.LastScheduleTime; t != nil {
+			lastSchedule = t.Time.Format(time.RFC3339)
+		}
+		problems = append(problems, Problem{
+			Namespace:          cj.Namespace,
+			Name:               cj.Name,
+			Schedule:           cj.Spec.Schedule,
+			LastSuccessfulTime: lastSuccess,
+			LastScheduleTime:   lastSchedule,
+			MissedSchedules:    fmt.Sprintf("more than %d", d.missedSchedules()),
+			ActiveJobs:         len(cj.Status.Active),
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "CronJobs without a recent successful run",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated CronJobs", Data: len(cronJobList.Items)},
+			{Description: "CronJobs with schedules not evaluated", Data: invalids},
+		},
+	}, nil
+}
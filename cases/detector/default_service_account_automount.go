@@ -0,0 +1,109 @@
+package detector
+
+import (
+	"strings"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &DefaultServiceAccountAutomount{}
+
+type DefaultServiceAccountAutomount struct {
+	// Pods in these namespaces are not evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*DefaultServiceAccountAutomount) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "default_service_account_automount",
+			Description: "Finding running pods with automounted tokens of the \"default\" service account",
+			Labels:      []string{"kubernetes", "rbac", "pod", "security"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:            {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sCoreV1ServiceAccountList: {Type: detek.TypeOf(v1.ServiceAccountList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods mount the token of the \"default\" ServiceAccount, which is shared by every pod in the namespace. Permissions granted to it for a workload are granted to every workload.",
+			Solution:    "Set automountServiceAccountToken to false in the pod (or the \"default\" ServiceAccount), and use a dedicated ServiceAccount for workloads calling the kubernetes API.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *DefaultServiceAccountAutomount) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	saList, err := detek.Typing[v1.ServiceAccountList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceAccountList, nil))
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+	// automountServiceAccountToken of "default" ServiceAccounts
+	saAutomount := map[string]*bool{}
+	for _, sa := range saList.Items {
+		if sa.Name == "default" {
+			saAutomount[sa.Namespace] = sa.AutomountServiceAccountToken
+		}
+	}
+
+	type Problem struct {
+		Namespace string
+		Workload  string
+		Pods      int
+	}
+	// aggregated by (namespace, workload)
+	problems := []*Problem{}
+	index := map[string]*Problem{}
+
+	for _, po := range podList.Items {
+		if po.Status.Phase != v1.PodRunning || excluded[po.Namespace] {
+			continue
+		}
+		if name := po.Spec.ServiceAccountName; name != "" && name != "default" {
+			continue
+		}
+		automount := po.Spec.AutomountServiceAccountToken
+		if automount == nil {
+			automount = saAutomount[po.Namespace]
+		}
+		if automount != nil && !*automount {
+			continue
+		}
+		workload := workloadOf(po)
+		if workload == "(none)" {
+			workload = "Pod/" + po.Name
+		}
+		key := strings.Join([]string{po.Namespace, workload}, "/")
+		if p, ok := index[key]; ok {
+			p.Pods++
+			continue
+		}
+		p := &Problem{Namespace: po.Namespace, Workload: workload, Pods: 1}
+		index[key] = p
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Workloads with automounted tokens of the default ServiceAccount",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+var _ detek.Detector = &DefaultStorageClass{}
+
+type DefaultStorageClass struct{}
+
+// GetMeta implements detek.Detector
+func (*DefaultStorageClass) GetMeta() detek.DetectorInfo {
+	multipleDefaults := detek.Description{
+		Explanation: "There are multiple default StorageClasses. Claims without a storageClassName may be provisioned by an unintended StorageClass (or rejected in kubernetes older than v1.26).",
+		Solution:    "Keep the \"storageclass.kubernetes.io/is-default-class\" annotation on one StorageClass only.",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "default_storage_class",
+			Description: "Checking there is exactly one default StorageClass",
+			Labels:      []string{"kubernetes", "storage", "storageclass"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sStorageV1StorageClassList: {Type: detek.TypeOf(storagev1.StorageClassList{})},
+		},
+		Level:      detek.Error,
+		IfHappened: multipleDefaults,
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: "There is no default StorageClass. Claims without a storageClassName will not be provisioned dynamically.",
+				Solution:    "Set the \"storageclass.kubernetes.io/is-default-class\" annotation to \"true\" on a StorageClass, if claims are expected to be provisioned by default.",
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*DefaultStorageClass) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	scList, err := detek.Typing[storagev1.StorageClassList](
+		ctx.Get(collector.KeyK8sStorageV1StorageClassList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		DefaultStorageClasses []string
+		Reason                string
+	}
+	defaults := []string{}
+	for _, sc := range scList.Items {
+		if isDefaultStorageClass(sc) {
+			defaults = append(defaults, sc.Name)
+		}
+	}
+
+	report := &detek.ReportSpec{
+		HasPassed: len(defaults) == 1,
+		Attachment: []detek.JSONableData{
+			{Description: "# of StorageClasses", Data: len(scList.Items)},
+		},
+	}
+	switch {
+	case len(defaults) == 0:
+		report.ObservedLevel = detek.Warn
+		report.Problem = detek.JSONableData{
+			Description: "Default StorageClasses",
+			Data:        Problem{DefaultStorageClasses: defaults, Reason: "no default StorageClass"},
+		}
+	case len(defaults) > 1:
+		report.ObservedLevel = detek.Error
+		report.Problem = detek.JSONableData{
+			Description: "Default StorageClasses",
+			Data:        Problem{DefaultStorageClasses: defaults, Reason: "multiple default StorageClasses"},
+		}
+	}
+	return report, nil
+}
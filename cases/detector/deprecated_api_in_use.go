@@ -0,0 +1,170 @@
+package detector
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+var _ detek.Detector = &DeprecatedAPIInUse{}
+
+type DeprecatedAPIInUse struct {
+	// (optional) Kubernetes version to upgrade to. (e.g, "1.27")
+	// if not set, the current version of the cluster will be used.
+	TargetVersion string
+}
+
+// GetMeta implements detek.Detector
+func (d *DeprecatedAPIInUse) GetMeta() detek.DetectorInfo {
+	target := targetVersionText(d.TargetVersion)
+	solution := "Migrate manifests (and tools managing them, e.g, helm charts, CI pipelines, operators) to the replacement APIs. " +
+		"For more information, please refer https://kubernetes.io/docs/reference/using-api/deprecation-guide/"
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "deprecated_api_in_use",
+			Description: fmt.Sprintf("Finding objects managed with apis deprecated or removed in %s", target),
+			Labels:      []string{"kubernetes", "api", "upgrade"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sVersion:          {Type: detek.TypeOf(version.Info{})},
+			collector.KeyK8sAPIResourceLists: {Type: detek.TypeOf([]metav1.APIResourceList{})},
+			collector.KeyK8sDynamicObjects:   {Type: detek.TypeOf(map[schema.GroupVersionResource]unstructured.UnstructuredList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: fmt.Sprintf("Some of objects are managed with apis which are unavailable in %s. Applying those manifests will fail.", target),
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of objects are managed with apis which are deprecated in %s, and will be removed in the future releases.", target),
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *DeprecatedAPIInUse) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serverVersion, err := detek.Typing[version.Info](
+		ctx.Get(collector.KeyK8sVersion, nil))
+	if err != nil {
+		return nil, err
+	}
+	lists, err := detek.Typing[[]metav1.APIResourceList](
+		ctx.Get(collector.KeyK8sAPIResourceLists, nil))
+	if err != nil {
+		return nil, err
+	}
+	objects, err := detek.Typing[map[schema.GroupVersionResource]unstructured.UnstructuredList](
+		ctx.Get(collector.KeyK8sDynamicObjects, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	current, target, err := versionsOf(serverVersion, d.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Kind         string
+		Namespace    string `json:",omitempty"`
+		Name         string
+		APIVersion   string
+		DeprecatedIn string
+		RemovedIn    string
+		Replacement  string
+		Evidence     string
+
+		removedIn *utilversion.Version
+	}
+	problems := []Problem{}
+	servedDeprecated := []string{}
+	observed := detek.Warn
+
+	for _, l := range APILifecycles {
+		if target.LessThan(l.DeprecatedIn) {
+			continue
+		}
+		gv := l.Resource.GroupVersion().String()
+		if !collector.IsServed(lists, gv, l.Resource.Resource) {
+			continue
+		}
+		servedDeprecated = append(servedDeprecated, l.Resource.String())
+		isRemoved := !target.LessThan(l.RemovedIn)
+
+		replacement := l.Replacement
+		if replacement == "" {
+			replacement = "(none)"
+		}
+		replacementServed := l.ReplacementGroupVersion() != "" && collector.IsServed(lists, l.ReplacementGroupVersion(), "")
+
+		for _, obj := range objects[l.Resource].Items {
+			evidence := usedAPIVersionOf(obj, gv)
+			if evidence == "" {
+				if replacementServed {
+					// can be managed with the replacement already
+					continue
+				}
+				evidence = "no replacement api is served"
+			}
+			if isRemoved {
+				observed = detek.Error
+			}
+			problems = append(problems, Problem{
+				Kind:         l.Kind,
+				Namespace:    obj.GetNamespace(),
+				Name:         obj.GetName(),
+				APIVersion:   gv,
+				DeprecatedIn: "v" + l.DeprecatedIn.String(),
+				RemovedIn:    "v" + l.RemovedIn.String(),
+				Replacement:  replacement,
+				Evidence:     evidence,
+				removedIn:    l.RemovedIn,
+			})
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].removedIn.LessThan(problems[j].removedIn)
+	})
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: fmt.Sprintf("Objects managed with deprecated apis (current: v%s, target: v%s)", current, target),
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "Deprecated apis served by the cluster", Data: servedDeprecated},
+			{Description: "# of known deprecated apis", Data: len(APILifecycles)},
+		},
+	}, nil
+}
+
+// usedAPIVersionOf returns why the object seems to be managed with a given group/version. (empty if not)
+func usedAPIVersionOf(obj unstructured.Unstructured, groupVersion string) string {
+	for _, mf := range obj.GetManagedFields() {
+		if mf.APIVersion == groupVersion {
+			return fmt.Sprintf("managed by %q with %s", mf.Manager, groupVersion)
+		}
+	}
+	if applied, ok := obj.GetAnnotations()["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		var lastApplied struct {
+			APIVersion string `json:"apiVersion"`
+		}
+		if err := json.Unmarshal([]byte(applied), &lastApplied); err == nil && lastApplied.APIVersion == groupVersion {
+			return fmt.Sprintf("last applied with %s", groupVersion)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,290 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// PodSpecDeprecation shows when a field (or an annotation) of pods is deprecated and removed.
+type PodSpecDeprecation struct {
+	Field        string
+	DeprecatedIn *utilversion.Version
+	// (optional) nil if the removal is not scheduled yet
+	RemovedIn   *utilversion.Version
+	Replacement string
+	// returns details of the usages in a given pod
+	find func(po v1.Pod) []string
+}
+
+// PodSpecDeprecations is a built-in table of deprecated fields and annotations of pods.
+var PodSpecDeprecations = []PodSpecDeprecation{
+	{
+		Field:        "seccomp annotations",
+		DeprecatedIn: majorMinor(1, 19),
+		RemovedIn:    majorMinor(1, 27),
+		Replacement:  "securityContext.seccompProfile",
+		find: func(po v1.Pod) []string {
+			return annotationsOf(po, func(key string) bool {
+				return key == v1.SeccompPodAnnotationKey || //nolint:staticcheck // finding the deprecated annotation
+					strings.HasPrefix(key, v1.SeccompContainerAnnotationKeyPrefix) //nolint:staticcheck // finding the deprecated annotation
+			})
+		},
+	},
+	{
+		Field:        "AppArmor annotations",
+		DeprecatedIn: majorMinor(1, 30),
+		Replacement:  "securityContext.appArmorProfile",
+		find: func(po v1.Pod) []string {
+			return annotationsOf(po, func(key string) bool {
+				return strings.HasPrefix(key, v1.AppArmorBetaContainerAnnotationKeyPrefix)
+			})
+		},
+	},
+	{
+		Field:        "critical-pod annotation",
+		DeprecatedIn: majorMinor(1, 13),
+		RemovedIn:    majorMinor(1, 16),
+		Replacement:  "priorityClassName (system-cluster-critical or system-node-critical)",
+		find: func(po v1.Pod) []string {
+			return annotationsOf(po, func(key string) bool {
+				return key == "scheduler.alpha.kubernetes.io/critical-pod"
+			})
+		},
+	},
+	{
+		Field:        "scaleIO volumes",
+		DeprecatedIn: majorMinor(1, 16),
+		RemovedIn:    majorMinor(1, 22),
+		Replacement:  "CSI driver",
+		find: func(po v1.Pod) []string {
+			return volumesOf(po, func(vol v1.Volume) bool { return vol.ScaleIO != nil })
+		},
+	},
+	{
+		Field:        "flocker, quobyte and storageos volumes",
+		DeprecatedIn: majorMinor(1, 22),
+		RemovedIn:    majorMinor(1, 25),
+		Replacement:  "CSI driver",
+		find: func(po v1.Pod) []string {
+			return volumesOf(po, func(vol v1.Volume) bool {
+				return vol.Flocker != nil || vol.Quobyte != nil || vol.StorageOS != nil
+			})
+		},
+	},
+	{
+		Field:        "glusterfs volumes",
+		DeprecatedIn: majorMinor(1, 25),
+		RemovedIn:    majorMinor(1, 26),
+		Replacement:  "CSI driver",
+		find: func(po v1.Pod) []string {
+			return volumesOf(po, func(vol v1.Volume) bool { return vol.Glusterfs != nil })
+		},
+	},
+	{
+		Field:        "beta.kubernetes.io/os, beta.kubernetes.io/arch labels",
+		DeprecatedIn: majorMinor(1, 14),
+		Replacement:  "kubernetes.io/os, kubernetes.io/arch labels",
+		find: func(po v1.Pod) []string {
+			return nodeLabelsOf(po, "beta.kubernetes.io/os", "beta.kubernetes.io/arch")
+		},
+	},
+	{
+		Field:        "failure-domain.beta.kubernetes.io labels",
+		DeprecatedIn: majorMinor(1, 17),
+		Replacement:  "topology.kubernetes.io/zone, topology.kubernetes.io/region labels",
+		find: func(po v1.Pod) []string {
+			return nodeLabelsOf(po, "failure-domain.beta.kubernetes.io/zone", "failure-domain.beta.kubernetes.io/region")
+		},
+	},
+}
+
+func annotationsOf(po v1.Pod, match func(key string) bool) []string {
+	result := []string{}
+	for key := range po.Annotations {
+		if match(key) {
+			result = append(result, fmt.Sprintf("annotation %q", key))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func volumesOf(po v1.Pod, match func(vol v1.Volume) bool) []string {
+	result := []string{}
+	for _, vol := range po.Spec.Volumes {
+		if match(vol) {
+			result = append(result, fmt.Sprintf("volume %q", vol.Name))
+		}
+	}
+	return result
+}
+
+// nodeLabelsOf finds given node labels in nodeSelector, nodeAffinity and topologySpreadConstraints.
+func nodeLabelsOf(po v1.Pod, labels ...string) []string {
+	deprecated := map[string]bool{}
+	for _, l := range labels {
+		deprecated[l] = true
+	}
+	found := map[string]bool{}
+	for key := range po.Spec.NodeSelector {
+		if deprecated[key] {
+			found["nodeSelector "+key] = true
+		}
+	}
+	if aff := po.Spec.Affinity; aff != nil && aff.NodeAffinity != nil {
+		terms := []v1.NodeSelectorTerm{}
+		if req := aff.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; req != nil {
+			terms = append(terms, req.NodeSelectorTerms...)
+		}
+		for _, pref := range aff.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, pref.Preference)
+		}
+		for _, term := range terms {
+			for _, expr := range term.MatchExpressions {
+				if deprecated[expr.Key] {
+					found["nodeAffinity "+expr.Key] = true
+				}
+			}
+		}
+	}
+	for _, tsc := range po.Spec.TopologySpreadConstraints {
+		if deprecated[tsc.TopologyKey] {
+			found["topologySpreadConstraints "+tsc.TopologyKey] = true
+		}
+	}
+	result := []string{}
+	for k := range found {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+var _ detek.Detector = &DeprecatedPodSpecField{}
+
+type DeprecatedPodSpecField struct {
+	// (optional) Kubernetes version to upgrade to. (e.g, "1.27")
+	// if not set, the current version of the cluster will be used.
+	TargetVersion string
+}
+
+// GetMeta implements detek.Detector
+func (d *DeprecatedPodSpecField) GetMeta() detek.DetectorInfo {
+	target := targetVersionText(d.TargetVersion)
+	solution := "Update pod templates of those workloads to use the replacements."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "deprecated_pod_spec_field",
+			Description: fmt.Sprintf("Finding pods using fields or annotations deprecated or removed in %s", target),
+			Labels:      []string{"kubernetes", "pod", "upgrade"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sVersion:       {Type: detek.TypeOf(version.Info{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: fmt.Sprintf("Some of pods are using fields or annotations which are ignored (or rejected) in %s.", target),
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of pods are using fields or annotations which are deprecated in %s.", target),
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *DeprecatedPodSpecField) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serverVersion, err := detek.Typing[version.Info](
+		ctx.Get(collector.KeyK8sVersion, nil))
+	if err != nil {
+		return nil, err
+	}
+	current, target, err := versionsOf(serverVersion, d.TargetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace    string
+		Workload     string
+		Field        string
+		Usages       []string
+		Pods         int
+		DeprecatedIn string
+		RemovedIn    string
+		Replacement  string
+	}
+	// aggregated by (namespace, workload, field), since pods of a workload share the same template
+	problems := []*Problem{}
+	index := map[string]*Problem{}
+	observed := detek.Warn
+
+	for _, dep := range PodSpecDeprecations {
+		if target.LessThan(dep.DeprecatedIn) {
+			continue
+		}
+		isRemoved := dep.RemovedIn != nil && !target.LessThan(dep.RemovedIn)
+		removedIn := "(not scheduled)"
+		if dep.RemovedIn != nil {
+			removedIn = "v" + dep.RemovedIn.String()
+		}
+		for _, po := range podList.Items {
+			usages := dep.find(po)
+			if len(usages) == 0 {
+				continue
+			}
+			if isRemoved {
+				observed = detek.Error
+			}
+			workload := workloadOf(po)
+			if workload == "(none)" {
+				workload = "Pod/" + po.Name
+			}
+			key := strings.Join([]string{po.Namespace, workload, dep.Field}, "/")
+			if p, ok := index[key]; ok {
+				p.Pods++
+				continue
+			}
+			p := &Problem{
+				Namespace:    po.Namespace,
+				Workload:     workload,
+				Field:        dep.Field,
+				Usages:       usages,
+				Pods:         1,
+				DeprecatedIn: "v" + dep.DeprecatedIn.String(),
+				RemovedIn:    removedIn,
+				Replacement:  dep.Replacement,
+			}
+			index[key] = p
+			problems = append(problems, p)
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: fmt.Sprintf("Workloads using deprecated pod fields (current: v%s, target: v%s)", current, target),
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package detector
+
+import (
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ detek.Detector = &FailedJob{}
+
+// FailedJob finds Jobs which exceeded their backoffLimit.
+// (Jobs exceeded their activeDeadlineSeconds are found by JobDeadlineExceeded)
+type FailedJob struct{}
+
+// GetMeta implements detek.Detector
+func (*FailedJob) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "failed_job",
+			Description: "Finding Jobs failed by exceeding their backoffLimit",
+			Labels:      []string{"kubernetes", "batch", "job"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sBatchV1JobList: {Type: detek.TypeOf(batchv1.JobList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Jobs have failed, since their pods failed more than backoffLimit times.",
+			Solution:    "Check logs of failed pods of those Jobs (`kubectl logs -n <namespace> job/<name>`), and rerun them after fixing the cause.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*FailedJob) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	jobList, err := detek.Typing[batchv1.JobList](
+		ctx.Get(collector.KeyK8sBatchV1JobList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace    string
+		Name         string
+		CronJob      string `json:",omitempty"`
+		BackoffLimit int32
+		Failed       int32
+		FailedAt     string
+		Message      string
+	}
+	problems := []Problem{}
+
+	for _, job := range jobList.Items {
+		cond := jobConditionOf(job, batchv1.JobFailed)
+		if cond == nil || cond.Reason != "BackoffLimitExceeded" {
+			continue
+		}
+		// default backoffLimit is 6
+		backoffLimit := int32(6)
+		if job.Spec.BackoffLimit != nil {
+			backoffLimit = *job.Spec.BackoffLimit
+		}
+		problems = append(problems, Problem{
+			Namespace:    job.Namespace,
+			Name:         job.Name,
+			CronJob:      cronJobOf(job),
+			BackoffLimit: backoffLimit,
+			Failed:       job.Status.Failed,
+			FailedAt:     cond.LastTransitionTime.Time.Format(time.RFC3339),
+			Message:      cond.Message,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Jobs exceeded their backoffLimit",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Jobs", Data: len(jobList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,117 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &FinishedJobWithoutTTL{}
+
+type FinishedJobWithoutTTL struct {
+	// namespaces with this number (or more) of finished Jobs without ttlSecondsAfterFinished will be reported. (default: 10)
+	MinFinishedJobs int
+}
+
+func (d *FinishedJobWithoutTTL) minFinishedJobs() int {
+	if d.MinFinishedJobs == 0 {
+		return 10
+	}
+	return d.MinFinishedJobs
+}
+
+// GetMeta implements detek.Detector
+func (d *FinishedJobWithoutTTL) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "finished_job_without_ttl",
+			Description: fmt.Sprintf("Finding namespaces with %d or more finished Jobs (not created by CronJobs) without ttlSecondsAfterFinished", d.minFinishedJobs()),
+			Labels:      []string{"kubernetes", "batch", "job", "pod"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sBatchV1JobList: {Type: detek.TypeOf(batchv1.JobList{})},
+			collector.KeyK8sCoreV1PodList:  {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Finished Jobs (and their pods) are never deleted without ttlSecondsAfterFinished, and accumulated. " +
+				"They are loading the kubernetes api server and etcd, and slow down listing pods.",
+			Solution: "Set ttlSecondsAfterFinished of those Jobs (and delete finished ones), or let CronJobs create them to limit their history.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *FinishedJobWithoutTTL) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	jobList, err := detek.Typing[batchv1.JobList](
+		ctx.Get(collector.KeyK8sBatchV1JobList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace    string
+		FinishedJobs int
+		Pods         int
+		Examples     []string
+	}
+	problems := []*Problem{}
+	index := map[string]*Problem{}
+
+	finished := map[string]bool{}
+	for _, job := range jobList.Items {
+		// CronJobs delete old jobs by their history limits
+		if job.Spec.TTLSecondsAfterFinished != nil || cronJobOf(job) != "" {
+			continue
+		}
+		if jobConditionOf(job, batchv1.JobComplete) == nil && jobConditionOf(job, batchv1.JobFailed) == nil {
+			continue
+		}
+		finished[job.Namespace+"/"+job.Name] = true
+		p, ok := index[job.Namespace]
+		if !ok {
+			p = &Problem{Namespace: job.Namespace}
+			index[job.Namespace] = p
+			problems = append(problems, p)
+		}
+		p.FinishedJobs++
+		if len(p.Examples) < 5 {
+			p.Examples = append(p.Examples, job.Name)
+		}
+	}
+	for _, po := range podList.Items {
+		for _, o := range po.OwnerReferences {
+			if o.Kind == "Job" && finished[po.Namespace+"/"+o.Name] {
+				index[po.Namespace].Pods++
+			}
+		}
+	}
+
+	result := []*Problem{}
+	for _, p := range problems {
+		if p.FinishedJobs >= d.minFinishedJobs() {
+			result = append(result, p)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].FinishedJobs > result[j].FinishedJobs })
+
+	return &detek.ReportSpec{
+		HasPassed: len(result) == 0,
+		Problem: detek.JSONableData{
+			Description: "Namespaces with finished Jobs accumulated",
+			Data:        result,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Jobs", Data: len(jobList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,87 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &FrequentlyRestartingPod{}
+
+type FrequentlyRestartingPod struct {
+	// containers restarted at least this many times will be reported. (default: 5)
+	MinRestartCount int32
+	// only containers restarted within this window will be reported. (default: 1h)
+	Window time.Duration
+}
+
+func (d *FrequentlyRestartingPod) minRestartCount() int32 {
+	if d.MinRestartCount == 0 {
+		return 5
+	}
+	return d.MinRestartCount
+}
+
+func (d *FrequentlyRestartingPod) window() time.Duration {
+	if d.Window == 0 {
+		return time.Hour
+	}
+	return d.Window
+}
+
+// GetMeta implements detek.Detector
+func (d *FrequentlyRestartingPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID: "frequently_restarting_pod",
+			Description: fmt.Sprintf("Finding containers restarted %d times or more, and restarted within the last %s",
+				d.minRestartCount(), d.window()),
+			Labels: []string{"kubernetes", "pod", "container"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of containers are restarting frequently. They may be crashing, or killed by failed liveness probes.",
+			Solution:    "Check logs of the previous run (`kubectl logs <pod> -c <container> --previous`), the last exit code and liveness probes.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *FrequentlyRestartingPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	problems := []containerProblem{}
+	for _, po := range podList.Items {
+		for _, cs := range containerStatusesOf(po) {
+			if cs.RestartCount < d.minRestartCount() {
+				continue
+			}
+			term := lastTerminated(cs)
+			if term == nil || time.Since(term.FinishedAt.Time) > d.window() {
+				continue
+			}
+			problems = append(problems, newContainerProblem(po, cs, term.Reason))
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Frequently restarting containers",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
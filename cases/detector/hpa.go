@@ -0,0 +1,76 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// scaleTarget is a workload which can be scaled by a HorizontalPodAutoscaler.
+type scaleTarget struct {
+	Kind     string
+	Name     string
+	Replicas *int32
+	Template v1.PodTemplateSpec
+	Object   *metav1.ObjectMeta
+}
+
+// scaleTargets indexes Deployments and StatefulSets by "<namespace>/<kind>/<name>".
+type scaleTargets map[string]scaleTarget
+
+func loadScaleTargets(ctx detek.DetekContext) (scaleTargets, error) {
+	deploymentList, err := detek.Typing[appsv1.DeploymentList](
+		ctx.Get(collector.KeyK8sAppsV1DeploymentList, nil))
+	if err != nil {
+		return nil, err
+	}
+	statefulSetList, err := detek.Typing[appsv1.StatefulSetList](
+		ctx.Get(collector.KeyK8sAppsV1StatefulSetList, nil))
+	if err != nil {
+		return nil, err
+	}
+	result := scaleTargets{}
+	for i, deploy := range deploymentList.Items {
+		result[deploy.Namespace+"/Deployment/"+deploy.Name] = scaleTarget{
+			Kind: "Deployment", Name: deploy.Name, Replicas: deploy.Spec.Replicas,
+			Template: deploy.Spec.Template, Object: &deploymentList.Items[i].ObjectMeta,
+		}
+	}
+	for i, sts := range statefulSetList.Items {
+		result[sts.Namespace+"/StatefulSet/"+sts.Name] = scaleTarget{
+			Kind: "StatefulSet", Name: sts.Name, Replicas: sts.Spec.Replicas,
+			Template: sts.Spec.Template, Object: &statefulSetList.Items[i].ObjectMeta,
+		}
+	}
+	return result, nil
+}
+
+// targetOf returns the target of the HPA.
+// known is false if the kind of the target is not collected (e.g, ReplicaSet, custom resources), so it can not be evaluated.
+func (t scaleTargets) targetOf(hpa v2.HorizontalPodAutoscaler) (target scaleTarget, found, known bool) {
+	ref := hpa.Spec.ScaleTargetRef
+	switch {
+	case ref.Kind == "Deployment" && (ref.APIVersion == "apps/v1" || ref.APIVersion == "extensions/v1beta1" || ref.APIVersion == ""):
+	case ref.Kind == "StatefulSet" && (ref.APIVersion == "apps/v1" || ref.APIVersion == ""):
+	default:
+		return scaleTarget{}, false, false
+	}
+	target, found = t[hpa.Namespace+"/"+ref.Kind+"/"+ref.Name]
+	return target, found, true
+}
+
+func hpaConditionOf(hpa v2.HorizontalPodAutoscaler, condType v2.HorizontalPodAutoscalerConditionType) *v2.HorizontalPodAutoscalerCondition {
+	for i, cond := range hpa.Status.Conditions {
+		if cond.Type == condType {
+			return &hpa.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func hpaTargetString(hpa v2.HorizontalPodAutoscaler) string {
+	return hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name
+}
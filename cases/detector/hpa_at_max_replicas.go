@@ -0,0 +1,86 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v2 "k8s.io/api/autoscaling/v2"
+)
+
+var _ detek.Detector = &HPAAtMaxReplicas{}
+
+type HPAAtMaxReplicas struct{}
+
+// GetMeta implements detek.Detector
+func (*HPAAtMaxReplicas) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "hpa_at_max_replicas",
+			Description: "Finding HorizontalPodAutoscalers pinned at maxReplicas",
+			Labels:      []string{"kubernetes", "autoscaling", "hpa"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of HorizontalPodAutoscalers are running maxReplicas, and want more. Those workloads can not scale out on more load.",
+			Solution:    "Raise maxReplicas of those HorizontalPodAutoscalers (check capacity of the cluster first), or check why the load is increased.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*HPAAtMaxReplicas) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	hpaList, err := detek.Typing[v2.HorizontalPodAutoscalerList](
+		ctx.Get(collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace       string
+		Name            string
+		Target          string
+		MaxReplicas     int32
+		CurrentReplicas int32
+		DesiredReplicas int32
+		Message         string `json:",omitempty"`
+	}
+	problems := []Problem{}
+
+	for _, hpa := range hpaList.Items {
+		if hpa.Status.CurrentReplicas < hpa.Spec.MaxReplicas {
+			continue
+		}
+		// ScalingLimited=True with "TooManyReplicas" means the HPA wants more than maxReplicas.
+		// (it may be absent in some versions, so desired replicas are compared as well)
+		cond := hpaConditionOf(hpa, v2.ScalingLimited)
+		limited := cond != nil && cond.Status == "True" && cond.Reason == "TooManyReplicas"
+		if !limited && hpa.Status.DesiredReplicas < hpa.Spec.MaxReplicas {
+			continue
+		}
+		p := Problem{
+			Namespace:       hpa.Namespace,
+			Name:            hpa.Name,
+			Target:          hpaTargetString(hpa),
+			MaxReplicas:     hpa.Spec.MaxReplicas,
+			CurrentReplicas: hpa.Status.CurrentReplicas,
+			DesiredReplicas: hpa.Status.DesiredReplicas,
+		}
+		if cond != nil && cond.Status == "True" {
+			p.Message = cond.Message
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "HorizontalPodAutoscalers pinned at maxReplicas",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated HorizontalPodAutoscalers", Data: len(hpaList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,129 @@
+package detector
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ detek.Detector = &HPAReplicasConflict{}
+
+type HPAReplicasConflict struct{}
+
+// GetMeta implements detek.Detector
+func (*HPAReplicasConflict) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "hpa_replicas_conflict",
+			Description: "Finding workloads whose 'spec.replicas' is managed by both a HorizontalPodAutoscaler and the other manager (e.g, kubectl apply, helm, gitops tools)",
+			Labels:      []string{"kubernetes", "autoscaling", "hpa", "workload"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+			collector.KeyK8sAppsV1DeploymentList:                     {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList:                    {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads scaled by HorizontalPodAutoscalers have 'spec.replicas' in their manifests. " +
+				"Each time the manifest is applied, replicas are reset and the HorizontalPodAutoscaler has to scale them again (pods may be terminated abruptly).",
+			Solution: "Remove 'spec.replicas' from manifests of those workloads, and let the HorizontalPodAutoscaler manage it. " +
+				"For more information, please refer https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/#migrating-deployments-and-statefulsets-to-horizontal-autoscaling",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*HPAReplicasConflict) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	hpaList, err := detek.Typing[v2.HorizontalPodAutoscalerList](
+		ctx.Get(collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList, nil))
+	if err != nil {
+		return nil, err
+	}
+	targets, err := loadScaleTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace   string
+		Target      string
+		HPA         string
+		Replicas    int32
+		MinReplicas int32
+		MaxReplicas int32
+		ManagedBy   []string
+	}
+	problems := []Problem{}
+
+	for _, hpa := range hpaList.Items {
+		target, found, _ := targets.targetOf(hpa)
+		if !found {
+			continue
+		}
+		managers := replicasManagersOf(target.Object)
+		if len(managers) == 0 {
+			continue
+		}
+		minReplicas := int32(1)
+		if hpa.Spec.MinReplicas != nil {
+			minReplicas = *hpa.Spec.MinReplicas
+		}
+		problems = append(problems, Problem{
+			Namespace:   hpa.Namespace,
+			Target:      hpaTargetString(hpa),
+			HPA:         hpa.Name,
+			Replicas:    replicasOf(target.Replicas),
+			MinReplicas: minReplicas,
+			MaxReplicas: hpa.Spec.MaxReplicas,
+			ManagedBy:   managers,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Workloads whose replicas are managed by both a HorizontalPodAutoscaler and the other manager",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated HorizontalPodAutoscalers", Data: len(hpaList.Items)},
+		},
+	}, nil
+}
+
+// replicasManagersOf returns managers (other than HorizontalPodAutoscalers) which set 'spec.replicas' of the object.
+func replicasManagersOf(obj *metav1.ObjectMeta) []string {
+	result := []string{}
+	for _, mf := range obj.ManagedFields {
+		// HorizontalPodAutoscalers update replicas through the scale subresource, as kube-controller-manager
+		if mf.Subresource == "scale" || mf.Manager == "kube-controller-manager" || mf.FieldsV1 == nil {
+			continue
+		}
+		var fields struct {
+			Spec map[string]json.RawMessage `json:"f:spec"`
+		}
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields.Spec["f:replicas"]; ok {
+			result = append(result, fmt.Sprintf("%s (%s)", mf.Manager, mf.Operation))
+		}
+	}
+	if applied, ok := obj.Annotations["kubectl.kubernetes.io/last-applied-configuration"]; ok {
+		var lastApplied struct {
+			Spec struct {
+				Replicas *int32 `json:"replicas"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal([]byte(applied), &lastApplied); err == nil && lastApplied.Spec.Replicas != nil {
+			result = append(result, fmt.Sprintf("kubectl apply (last applied replicas: %d)", *lastApplied.Spec.Replicas))
+		}
+	}
+	return result
+}
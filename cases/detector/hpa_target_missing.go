@@ -0,0 +1,81 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+)
+
+var _ detek.Detector = &HPATargetMissing{}
+
+type HPATargetMissing struct{}
+
+// GetMeta implements detek.Detector
+func (*HPATargetMissing) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "hpa_target_missing",
+			Description: "Finding HorizontalPodAutoscalers targeting Deployments or StatefulSets which do not exist",
+			Labels:      []string{"kubernetes", "autoscaling", "hpa"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+			collector.KeyK8sAppsV1DeploymentList:                     {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList:                    {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of HorizontalPodAutoscalers are targeting workloads which do not exist. The target may be renamed, or deleted without its HorizontalPodAutoscaler.",
+			Solution:    "Fix scaleTargetRef of those HorizontalPodAutoscalers, or delete them if they are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*HPATargetMissing) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	hpaList, err := detek.Typing[v2.HorizontalPodAutoscalerList](
+		ctx.Get(collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList, nil))
+	if err != nil {
+		return nil, err
+	}
+	targets, err := loadScaleTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Target    string
+	}
+	problems := []Problem{}
+	skipped := 0
+
+	for _, hpa := range hpaList.Items {
+		_, found, known := targets.targetOf(hpa)
+		if !known {
+			skipped++
+			continue
+		}
+		if !found {
+			problems = append(problems, Problem{
+				Namespace: hpa.Namespace,
+				Name:      hpa.Name,
+				Target:    hpaTargetString(hpa),
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "HorizontalPodAutoscalers targeting missing workloads",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated HorizontalPodAutoscalers", Data: len(hpaList.Items) - skipped},
+			{Description: "# of skipped HorizontalPodAutoscalers (targets other than Deployment or StatefulSet)", Data: skipped},
+		},
+	}, nil
+}
@@ -0,0 +1,104 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &HPATargetWithoutRequests{}
+
+type HPATargetWithoutRequests struct{}
+
+// GetMeta implements detek.Detector
+func (*HPATargetWithoutRequests) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "hpa_target_without_requests",
+			Description: "Finding HorizontalPodAutoscalers scaling on resource utilization, whose targets have no requests of the resource",
+			Labels:      []string{"kubernetes", "autoscaling", "hpa", "pod"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+			collector.KeyK8sAppsV1DeploymentList:                     {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList:                    {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Utilization is a ratio of usage to requests. HorizontalPodAutoscalers can not calculate it for containers without requests of the resource, " +
+				"so those workloads will not be scaled.",
+			Solution: "Set requests of the resource for every container of the target (or use 'AverageValue' targets instead of 'Utilization').",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*HPATargetWithoutRequests) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	hpaList, err := detek.Typing[v2.HorizontalPodAutoscalerList](
+		ctx.Get(collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList, nil))
+	if err != nil {
+		return nil, err
+	}
+	targets, err := loadScaleTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Target    string
+		Resource  v1.ResourceName
+		Container string
+	}
+	problems := []Problem{}
+
+	for _, hpa := range hpaList.Items {
+		target, found, _ := targets.targetOf(hpa)
+		if !found {
+			continue
+		}
+		for _, metric := range hpa.Spec.Metrics {
+			var resourceName v1.ResourceName
+			var container string
+			switch {
+			case metric.Type == v2.ResourceMetricSourceType && metric.Resource != nil &&
+				metric.Resource.Target.Type == v2.UtilizationMetricType:
+				resourceName = metric.Resource.Name
+			case metric.Type == v2.ContainerResourceMetricSourceType && metric.ContainerResource != nil &&
+				metric.ContainerResource.Target.Type == v2.UtilizationMetricType:
+				resourceName, container = metric.ContainerResource.Name, metric.ContainerResource.Container
+			default:
+				continue
+			}
+			for _, co := range target.Template.Spec.Containers {
+				if container != "" && co.Name != container {
+					continue
+				}
+				if _, ok := co.Resources.Requests[resourceName]; ok {
+					continue
+				}
+				problems = append(problems, Problem{
+					Namespace: hpa.Namespace,
+					Name:      hpa.Name,
+					Target:    hpaTargetString(hpa),
+					Resource:  resourceName,
+					Container: co.Name,
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Containers without requests, which HorizontalPodAutoscalers depend on",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated HorizontalPodAutoscalers", Data: len(hpaList.Items)},
+		},
+	}, nil
+}
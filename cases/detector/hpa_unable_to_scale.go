@@ -0,0 +1,82 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v2 "k8s.io/api/autoscaling/v2"
+)
+
+var _ detek.Detector = &HPAUnableToScale{}
+
+type HPAUnableToScale struct{}
+
+// GetMeta implements detek.Detector
+func (*HPAUnableToScale) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "hpa_unable_to_scale",
+			Description: "Finding HorizontalPodAutoscalers with 'ScalingActive=False' or 'AbleToScale=False' (e.g, unable to fetch metrics)",
+			Labels:      []string{"kubernetes", "autoscaling", "hpa"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList: {Type: detek.TypeOf(v2.HorizontalPodAutoscalerList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of HorizontalPodAutoscalers are not working. Usually, they can not fetch metrics (e.g, metrics-server is not installed, or pods have no requests), " +
+				"or can not access the scale subresource of the target.",
+			Solution: "Check reasons and messages of conditions (`kubectl describe hpa <name>`), and fix the metrics pipeline or the target.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*HPAUnableToScale) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	hpaList, err := detek.Typing[v2.HorizontalPodAutoscalerList](
+		ctx.Get(collector.KeyK8sAutoscalingV2HorizontalPodAutoscalerList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Target    string
+		Condition v2.HorizontalPodAutoscalerConditionType
+		Reason    string
+		Message   string
+	}
+	problems := []Problem{}
+
+	for _, hpa := range hpaList.Items {
+		for _, condType := range []v2.HorizontalPodAutoscalerConditionType{v2.AbleToScale, v2.ScalingActive} {
+			cond := hpaConditionOf(hpa, condType)
+			if cond == nil || cond.Status != "False" {
+				continue
+			}
+			// scaling is disabled on purpose, when the target is scaled to zero replicas
+			if condType == v2.ScalingActive && cond.Reason == "ScalingDisabled" {
+				continue
+			}
+			problems = append(problems, Problem{
+				Namespace: hpa.Namespace,
+				Name:      hpa.Name,
+				Target:    hpaTargetString(hpa),
+				Condition: condType,
+				Reason:    cond.Reason,
+				Message:   cond.Message,
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "HorizontalPodAutoscalers unable to scale",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated HorizontalPodAutoscalers", Data: len(hpaList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,71 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &ImagePullFailedPod{}
+
+type ImagePullFailedPod struct{}
+
+// GetMeta implements detek.Detector
+func (*ImagePullFailedPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "image_pull_failed_pod",
+			Description: "Finding containers which can not pull their images (ImagePullBackOff, ErrImagePull, InvalidImageName)",
+			Labels:      []string{"kubernetes", "pod", "container", "image"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of containers can not be started, since their images can not be pulled.",
+			Solution:    "Check if the image name and tag exist, the registry is reachable from nodes, and imagePullSecrets are valid.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*ImagePullFailedPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		containerProblem
+		Image string
+	}
+	problems := []Problem{}
+	for _, po := range podList.Items {
+		for _, cs := range containerStatusesOf(po) {
+			waiting := cs.State.Waiting
+			if waiting == nil {
+				continue
+			}
+			switch waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "InvalidImageName":
+				problems = append(problems, Problem{
+					containerProblem: newContainerProblem(po, cs, waiting.Reason),
+					Image:            cs.Image,
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Containers failed to pull images",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,48 @@
+package detector
+
+import (
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type ingressBackend struct {
+	// e.g, "example.com/api" or "(default)"
+	Route   string
+	Backend networkingv1.IngressBackend
+}
+
+// ingressBackendsOf returns every backend of a given Ingress, including the default backend.
+func ingressBackendsOf(ing networkingv1.Ingress) []ingressBackend {
+	result := []ingressBackend{}
+	if ing.Spec.DefaultBackend != nil {
+		result = append(result, ingressBackend{"(default)", *ing.Spec.DefaultBackend})
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		for _, path := range rule.HTTP.Paths {
+			result = append(result, ingressBackend{host + path.Path, path.Backend})
+		}
+	}
+	return result
+}
+
+// matchesCertificateHost returns whether a host is covered by a DNS name of a certificate.
+// (a wildcard matches exactly one label, e.g, "*.example.com" matches "a.example.com" but not "a.b.example.com")
+func matchesCertificateHost(dnsName, host string) bool {
+	dnsName, host = strings.ToLower(dnsName), strings.ToLower(host)
+	if dnsName == host {
+		return true
+	}
+	if !strings.HasPrefix(dnsName, "*.") {
+		return false
+	}
+	_, parent, ok := strings.Cut(host, ".")
+	return ok && !strings.HasPrefix(host, "*") && parent == dnsName[2:]
+}
@@ -0,0 +1,112 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &IngressBackendMissing{}
+
+type IngressBackendMissing struct{}
+
+// GetMeta implements detek.Detector
+func (*IngressBackendMissing) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "ingress_backend_missing",
+			Description: "Finding ingresses whose backend service (or port) does not exist",
+			Labels:      []string{"kubernetes", "ingress", "service"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sNetworkingV1IngressList: {Type: detek.TypeOf(networkingv1.IngressList{})},
+			collector.KeyK8sCoreV1ServiceList:       {Type: detek.TypeOf(v1.ServiceList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Ingresses route traffic to Services (or ports) which do not exist. Requests to those routes will fail.",
+			Solution:    "Fix the backend of those Ingresses, or create the missing Services.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*IngressBackendMissing) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	ingressList, err := detek.Typing[networkingv1.IngressList](
+		ctx.Get(collector.KeyK8sNetworkingV1IngressList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	services := map[types.NamespacedName]v1.Service{}
+	for _, svc := range serviceList.Items {
+		services[types.NamespacedName{Namespace: svc.Namespace, Name: svc.Name}] = svc
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Route     string
+		Backend   string
+		Reason    string
+	}
+	problems := []Problem{}
+
+	for _, ing := range ingressList.Items {
+		for _, b := range ingressBackendsOf(ing) {
+			backend := b.Backend.Service
+			if backend == nil {
+				// resource backends are not evaluated
+				continue
+			}
+			port := backend.Port.Name
+			if port == "" {
+				port = fmt.Sprintf("%d", backend.Port.Number)
+			}
+			p := Problem{
+				Namespace: ing.Namespace,
+				Name:      ing.Name,
+				Route:     b.Route,
+				Backend:   fmt.Sprintf("Service/%s:%s", backend.Name, port),
+			}
+			svc, ok := services[types.NamespacedName{Namespace: ing.Namespace, Name: backend.Name}]
+			if !ok {
+				p.Reason = "service does not exist"
+				problems = append(problems, p)
+				continue
+			}
+			if !hasServicePort(svc, backend.Port) {
+				p.Reason = "service does not have the port"
+				problems = append(problems, p)
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Ingress routes with missing backends",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Ingresses", Data: len(ingressList.Items)},
+		},
+	}, nil
+}
+
+func hasServicePort(svc v1.Service, port networkingv1.ServiceBackendPort) bool {
+	for _, sp := range svc.Spec.Ports {
+		if (port.Name != "" && sp.Name == port.Name) || (port.Name == "" && sp.Port == port.Number) {
+			return true
+		}
+	}
+	return false
+}
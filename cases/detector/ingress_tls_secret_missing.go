@@ -0,0 +1,87 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &IngressTLSSecretMissing{}
+
+type IngressTLSSecretMissing struct{}
+
+// GetMeta implements detek.Detector
+func (*IngressTLSSecretMissing) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "ingress_tls_secret_missing",
+			Description: "Finding ingresses referencing TLS secrets which do not exist",
+			Labels:      []string{"kubernetes", "ingress", "tls"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sNetworkingV1IngressList: {Type: detek.TypeOf(networkingv1.IngressList{})},
+			collector.KeyK8sTLSCertificates:         {Type: detek.TypeOf([]collector.TLSCertificate{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Ingresses reference TLS secrets which do not exist (or are not \"kubernetes.io/tls\" type). Most of ingress controllers will serve a default (self-signed) certificate for those hosts.",
+			Solution:    "Create the secret (e.g, by cert-manager), or fix the secretName of the Ingress.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*IngressTLSSecretMissing) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	ingressList, err := detek.Typing[networkingv1.IngressList](
+		ctx.Get(collector.KeyK8sNetworkingV1IngressList, nil))
+	if err != nil {
+		return nil, err
+	}
+	certs, err := detek.Typing[[]collector.TLSCertificate](
+		ctx.Get(collector.KeyK8sTLSCertificates, nil))
+	if err != nil {
+		return nil, err
+	}
+	secrets := map[types.NamespacedName]bool{}
+	for _, cert := range certs {
+		secrets[types.NamespacedName{Namespace: cert.Namespace, Name: cert.Name}] = true
+	}
+
+	type Problem struct {
+		Namespace  string
+		Name       string
+		SecretName string
+		Hosts      []string
+	}
+	problems := []Problem{}
+
+	for _, ing := range ingressList.Items {
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName == "" {
+				// the default certificate of the ingress controller will be used
+				continue
+			}
+			if !secrets[types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName}] {
+				problems = append(problems, Problem{
+					Namespace:  ing.Namespace,
+					Name:       ing.Name,
+					SecretName: tls.SecretName,
+					Hosts:      tls.Hosts,
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Ingresses with missing TLS secrets",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Ingresses", Data: len(ingressList.Items)},
+			{Description: "# of TLS secrets", Data: len(certs)},
+		},
+	}, nil
+}
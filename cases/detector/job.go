@@ -0,0 +1,26 @@
+package detector
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// jobConditionOf returns the condition of the job, if its status is True.
+func jobConditionOf(job batchv1.Job, condType batchv1.JobConditionType) *batchv1.JobCondition {
+	for i, cond := range job.Status.Conditions {
+		if cond.Type == condType && cond.Status == v1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// cronJobOf returns the name of the CronJob which created the job. (empty if not created by a CronJob)
+func cronJobOf(job batchv1.Job) string {
+	for _, o := range job.OwnerReferences {
+		if o.Kind == "CronJob" {
+			return o.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+package detector
+
+import (
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ detek.Detector = &JobDeadlineExceeded{}
+
+type JobDeadlineExceeded struct{}
+
+// GetMeta implements detek.Detector
+func (*JobDeadlineExceeded) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "job_deadline_exceeded",
+			Description: "Finding Jobs running longer than their activeDeadlineSeconds",
+			Labels:      []string{"kubernetes", "batch", "job"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sBatchV1JobList: {Type: detek.TypeOf(batchv1.JobList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Jobs have run longer than their activeDeadlineSeconds, and their pods are terminated before finishing.",
+			Solution:    "Check why those Jobs are slow (e.g, stuck, throttled on cpu, or the input is grown), and raise activeDeadlineSeconds if needed.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*JobDeadlineExceeded) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	jobList, err := detek.Typing[batchv1.JobList](
+		ctx.Get(collector.KeyK8sBatchV1JobList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace      string
+		Name           string
+		CronJob        string `json:",omitempty"`
+		ActiveDeadline string
+		Running        string
+		Message        string `json:",omitempty"`
+	}
+	problems := []Problem{}
+
+	now := time.Now()
+	for _, job := range jobList.Items {
+		if job.Spec.ActiveDeadlineSeconds == nil || job.Status.StartTime == nil {
+			continue
+		}
+		deadline := time.Duration(*job.Spec.ActiveDeadlineSeconds) * time.Second
+		p := Problem{
+			Namespace:      job.Namespace,
+			Name:           job.Name,
+			CronJob:        cronJobOf(job),
+			ActiveDeadline: deadline.String(),
+		}
+		if cond := jobConditionOf(job, batchv1.JobFailed); cond != nil && cond.Reason == "DeadlineExceeded" {
+			p.Running = cond.LastTransitionTime.Sub(job.Status.StartTime.Time).Truncate(time.Second).String()
+			p.Message = cond.Message
+		} else if job.Status.CompletionTime == nil && jobConditionOf(job, batchv1.JobFailed) == nil && now.Sub(job.Status.StartTime.Time) > deadline {
+			// still running over the deadline (the controller may not be working)
+			p.Running = now.Sub(job.Status.StartTime.Time).Truncate(time.Second).String()
+		} else {
+			continue
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Jobs exceeded their activeDeadlineSeconds",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Jobs", Data: len(jobList.Items)},
+		},
+	}, nil
+}
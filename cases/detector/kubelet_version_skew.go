@@ -0,0 +1,131 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+var _ detek.Detector = &KubeletVersionSkew{}
+
+type KubeletVersionSkew struct {
+	// kubelets older than the control plane by more than this minor versions will be reported.
+	// (default: 2, see https://kubernetes.io/releases/version-skew-policy/#kubelet)
+	MaxMinorSkew uint
+	Threshold    RatioThreshold
+	// (optional) Kubernetes version to upgrade the control plane to. (e.g, "1.27")
+	// if set, kubelets will be compared with this version instead of the current control plane.
+	TargetVersion string
+}
+
+func (d *KubeletVersionSkew) maxMinorSkew() uint {
+	if d.MaxMinorSkew == 0 {
+		return 2
+	}
+	return d.MaxMinorSkew
+}
+
+// GetMeta implements detek.Detector
+func (d *KubeletVersionSkew) GetMeta() detek.DetectorInfo {
+	controlPlane := "the control plane"
+	if d.TargetVersion != "" {
+		controlPlane = fmt.Sprintf("the control plane (%s)", targetVersionText(d.TargetVersion))
+	}
+	ifHappened := detek.Description{
+		Explanation: fmt.Sprintf("Some of kubelets are out of the supported version skew against %s, which is not tested and may not work properly.", controlPlane),
+		Solution: "Upgrade (or replace) those nodes to match the version of the control plane. " +
+			"For more information, please refer https://kubernetes.io/releases/version-skew-policy/",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID: "kubelet_version_skew",
+			Description: fmt.Sprintf("Finding kubelets newer than %s, or older than it by more than %d minor versions",
+				controlPlane, d.maxMinorSkew()),
+			Labels: []string{"kubernetes", "node", "version"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList: {Type: detek.TypeOf(v1.NodeList{})},
+			collector.KeyK8sVersion:        {Type: detek.TypeOf(version.Info{})},
+		},
+		Level:            detek.Fatal,
+		IfHappened:       ifHappened,
+		LevelDescription: d.Threshold.Describe(ifHappened),
+	}
+}
+
+// Do implements detek.Detector
+func (d *KubeletVersionSkew) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serverVersion, err := detek.Typing[version.Info](
+		ctx.Get(collector.KeyK8sVersion, nil))
+	if err != nil {
+		return nil, err
+	}
+	_, controlPlane, err := versionsOf(serverVersion, d.TargetVersion)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse version of the control plane: %w", err)
+	}
+
+	type Problem struct {
+		Name           string
+		KubeletVersion string
+		Reason         string
+	}
+	problems := []Problem{}
+
+	for _, no := range nodeList.Items {
+		kubeletVersion := no.Status.NodeInfo.KubeletVersion
+		kubelet, err := utilversion.ParseGeneric(kubeletVersion)
+		if err != nil {
+			problems = append(problems, Problem{
+				Name:           no.Name,
+				KubeletVersion: kubeletVersion,
+				Reason:         fmt.Sprintf("fail to parse kubelet version: %v", err),
+			})
+			continue
+		}
+		if kubelet.Major() != controlPlane.Major() {
+			problems = append(problems, Problem{
+				Name:           no.Name,
+				KubeletVersion: kubeletVersion,
+				Reason:         "major version is different from the control plane",
+			})
+			continue
+		}
+		if kubelet.Minor() > controlPlane.Minor() {
+			problems = append(problems, Problem{
+				Name:           no.Name,
+				KubeletVersion: kubeletVersion,
+				Reason:         "kubelet is newer than the control plane",
+			})
+			continue
+		}
+		if skew := controlPlane.Minor() - kubelet.Minor(); skew > d.maxMinorSkew() {
+			problems = append(problems, Problem{
+				Name:           no.Name,
+				KubeletVersion: kubeletVersion,
+				Reason:         fmt.Sprintf("kubelet is %d minor versions older than the control plane", skew),
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: d.Threshold.LevelOf(len(problems), len(nodeList.Items)),
+		Problem: detek.JSONableData{
+			Description: fmt.Sprintf("Nodes out of the version skew policy (control plane: v%s)", controlPlane),
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Nodes", Data: len(nodeList.Items)},
+		},
+	}, nil
+}
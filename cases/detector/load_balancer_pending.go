@@ -0,0 +1,96 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &LoadBalancerPending{}
+
+type LoadBalancerPending struct {
+	// LoadBalancer Services without an ingress longer than this will be reported. (default: 10m)
+	MaxPendingDuration time.Duration
+}
+
+func (d *LoadBalancerPending) maxPendingDuration() time.Duration {
+	if d.MaxPendingDuration == 0 {
+		return 10 * time.Minute
+	}
+	return d.MaxPendingDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *LoadBalancerPending) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "load_balancer_pending",
+			Description: fmt.Sprintf("Finding LoadBalancer services without an ingress IP (or hostname) longer than %s", d.maxPendingDuration()),
+			Labels:      []string{"kubernetes", "service", "loadbalancer"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1ServiceList: {Type: detek.TypeOf(v1.ServiceList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of LoadBalancer Services are not provisioned. They are not reachable from outside of the cluster.",
+			Solution:    "Check events of the Service, and logs of the cloud controller manager (or the load balancer controller) of the cluster.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *LoadBalancerPending) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace         string
+		Name              string
+		LoadBalancerClass string `json:",omitempty"`
+		PendingFor        string
+	}
+	problems := []Problem{}
+	evaluated := 0
+
+	now := time.Now()
+	for _, svc := range serviceList.Items {
+		if svc.Spec.Type != v1.ServiceTypeLoadBalancer {
+			continue
+		}
+		evaluated++
+		if len(svc.Status.LoadBalancer.Ingress) != 0 {
+			continue
+		}
+		pendingFor := now.Sub(svc.CreationTimestamp.Time)
+		if pendingFor < d.maxPendingDuration() {
+			continue
+		}
+		p := Problem{
+			Namespace:  svc.Namespace,
+			Name:       svc.Name,
+			PendingFor: pendingFor.Truncate(time.Second).String(),
+		}
+		if svc.Spec.LoadBalancerClass != nil {
+			p.LoadBalancerClass = *svc.Spec.LoadBalancerClass
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "LoadBalancer Services without an ingress",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated LoadBalancer Services", Data: evaluated},
+		},
+	}, nil
+}
@@ -0,0 +1,139 @@
+package detector
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &LongCordonedNode{}
+
+type LongCordonedNode struct {
+	// nodes cordoned longer than this will be reported. (default: 24h)
+	MaxCordonedDuration time.Duration
+	Threshold           RatioThreshold
+}
+
+func (d *LongCordonedNode) maxCordonedDuration() time.Duration {
+	if d.MaxCordonedDuration == 0 {
+		return 24 * time.Hour
+	}
+	return d.MaxCordonedDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *LongCordonedNode) GetMeta() detek.DetectorInfo {
+	ifHappened := detek.Description{
+		Explanation: "Some of nodes have been cordoned (unschedulable) for a long time, which reduces the capacity of the cluster.",
+		Solution:    "Finish the maintenance and uncordon those nodes (`kubectl uncordon <name>`), or remove them from the cluster.",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "long_cordoned_node",
+			Description: fmt.Sprintf("Finding nodes cordoned longer than %s", d.maxCordonedDuration()),
+			Labels:      []string{"kubernetes", "node"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList: {Type: detek.TypeOf(v1.NodeList{})},
+		},
+		Level:            detek.Fatal,
+		IfHappened:       ifHappened,
+		LevelDescription: d.Threshold.Describe(ifHappened),
+	}
+}
+
+// Do implements detek.Detector
+func (d *LongCordonedNode) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name        string
+		CordonedAt  string
+		CordonedFor string
+		NodeIsReady bool
+		ExtraTaints []string `json:",omitempty"`
+	}
+	problems := []Problem{}
+	cordoned := 0
+
+	for _, no := range nodeList.Items {
+		if !no.Spec.Unschedulable {
+			continue
+		}
+		cordoned++
+		extraTaints := []string{}
+		for _, taint := range no.Spec.Taints {
+			if taint.Key == v1.TaintNodeUnschedulable {
+				continue
+			}
+			extraTaints = append(extraTaints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+		cordonedAtText, cordonedFor := "(unknown)", "(unknown)"
+		if cordonedAt := cordonedAtOf(no); cordonedAt != nil {
+			duration := time.Since(*cordonedAt)
+			if duration < d.maxCordonedDuration() {
+				continue
+			}
+			cordonedAtText, cordonedFor = cordonedAt.UTC().Format(time.RFC3339), duration.Round(time.Minute).String()
+		}
+		isReady := false
+		for _, cond := range no.Status.Conditions {
+			if cond.Type == v1.NodeReady && cond.Status == v1.ConditionTrue {
+				isReady = true
+			}
+		}
+		problems = append(problems, Problem{
+			Name:        no.Name,
+			CordonedAt:  cordonedAtText,
+			CordonedFor: cordonedFor,
+			NodeIsReady: isReady,
+			ExtraTaints: extraTaints,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: d.Threshold.LevelOf(len(problems), len(nodeList.Items)),
+		Problem: detek.JSONableData{
+			Description: "Nodes cordoned for a long time",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Nodes", Data: len(nodeList.Items)},
+			{Description: "# of cordoned Nodes", Data: cordoned},
+		},
+	}, nil
+}
+
+// cordonedAtOf returns when 'spec.unschedulable' was set, from managedFields. (nil if unknown)
+// (TimeAdded of the "node.kubernetes.io/unschedulable" taint is not set, since it is only for NoExecute taints)
+// the time of a managedFields entry is the last update by the manager, so it may be later than the actual cordon.
+func cordonedAtOf(no v1.Node) *time.Time {
+	var result *time.Time
+	for _, mf := range no.ManagedFields {
+		if mf.Time == nil || mf.FieldsV1 == nil {
+			continue
+		}
+		var fields struct {
+			Spec map[string]json.RawMessage `json:"f:spec"`
+		}
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields.Spec["f:unschedulable"]; !ok {
+			continue
+		}
+		if t := mf.Time.Time; result == nil || t.After(*result) {
+			result = &t
+		}
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+)
+
+// metricsUnavailable is a report for detectors depending on metrics.k8s.io, when it is not served.
+// those detectors pass, since nothing can be evaluated.
+func metricsUnavailable() *detek.ReportSpec {
+	return &detek.ReportSpec{
+		HasPassed: true,
+		Attachment: []detek.JSONableData{
+			{Description: "skipped", Data: "metrics.k8s.io is not served (metrics-server may not be installed)"},
+		},
+	}
+}
+
+// loadPodMetrics returns pod metrics by "<namespace>/<name>". (ok is false if metrics.k8s.io is not served)
+func loadPodMetrics(ctx detek.DetekContext) (result map[string]collector.PodMetrics, ok bool, err error) {
+	podMetrics, err := detek.Typing[[]collector.PodMetrics](
+		ctx.Get(collector.KeyK8sMetricsV1Beta1PodMetrics, nil))
+	if detek.IsKeyNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	result = make(map[string]collector.PodMetrics)
+	for _, m := range podMetrics {
+		result[m.Namespace+"/"+m.Name] = m
+	}
+	return result, true, nil
+}
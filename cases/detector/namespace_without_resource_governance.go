@@ -0,0 +1,97 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &NamespaceWithoutResourceGovernance{}
+
+type NamespaceWithoutResourceGovernance struct {
+	// Namespaces not to be evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*NamespaceWithoutResourceGovernance) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "namespace_without_resource_governance",
+			Description: "Finding namespaces without a ResourceQuota or a LimitRange",
+			Labels:      []string{"kubernetes", "namespace", "resourcequota", "limitrange"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NamespaceList:     {Type: detek.TypeOf(v1.NamespaceList{})},
+			collector.KeyK8sCoreV1ResourceQuotaList: {Type: detek.TypeOf(v1.ResourceQuotaList{})},
+			collector.KeyK8sCoreV1LimitRangeList:    {Type: detek.TypeOf(v1.LimitRangeList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of namespaces have no ResourceQuota (nothing limits the total resources they use) or no LimitRange (pods without requests and limits are admitted).",
+			Solution: "Add a ResourceQuota and a LimitRange (with default requests and limits) to each namespace. " +
+				"For more information, please refer https://kubernetes.io/docs/concepts/policy/resource-quotas/ and https://kubernetes.io/docs/concepts/policy/limit-range/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *NamespaceWithoutResourceGovernance) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nsList, err := detek.Typing[v1.NamespaceList](
+		ctx.Get(collector.KeyK8sCoreV1NamespaceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	quotaList, err := detek.Typing[v1.ResourceQuotaList](
+		ctx.Get(collector.KeyK8sCoreV1ResourceQuotaList, nil))
+	if err != nil {
+		return nil, err
+	}
+	limitRangeList, err := detek.Typing[v1.LimitRangeList](
+		ctx.Get(collector.KeyK8sCoreV1LimitRangeList, nil))
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+	hasQuota, hasLimitRange := map[string]bool{}, map[string]bool{}
+	for _, q := range quotaList.Items {
+		hasQuota[q.Namespace] = true
+	}
+	for _, lr := range limitRangeList.Items {
+		hasLimitRange[lr.Namespace] = true
+	}
+
+	type Problem struct {
+		Namespace     string
+		ResourceQuota bool
+		LimitRange    bool
+	}
+	problems := []Problem{}
+
+	for _, ns := range nsList.Items {
+		if excluded[ns.Name] || ns.Status.Phase == v1.NamespaceTerminating {
+			continue
+		}
+		if !hasQuota[ns.Name] || !hasLimitRange[ns.Name] {
+			problems = append(problems, Problem{
+				Namespace:     ns.Name,
+				ResourceQuota: hasQuota[ns.Name],
+				LimitRange:    hasLimitRange[ns.Name],
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Namespaces without a ResourceQuota or a LimitRange",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Namespaces", Data: len(nsList.Items)},
+		},
+	}, nil
+}
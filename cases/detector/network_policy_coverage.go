@@ -0,0 +1,253 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ detek.Detector = &NetworkPolicyCoverage{}
+
+type NetworkPolicyCoverage struct {
+	// Namespaces not to be evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*NetworkPolicyCoverage) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "network_policy_coverage",
+			Description: "Evaluating which pods are not isolated by NetworkPolicies, for each namespace",
+			Labels:      []string{"kubernetes", "networkpolicy", "security"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:                 {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sNetworkingV1NetworkPolicyList: {Type: detek.TypeOf(networkingv1.NetworkPolicyList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods are not selected by any NetworkPolicy, so they allow every traffic (default-allow), or selected by NetworkPolicies allowing every traffic (e.g, \"ingress: [{}]\"). Or some of NetworkPolicies select no pods.",
+			Solution: "Add a default-deny NetworkPolicy (with an empty podSelector) in each namespace, and allow required traffic explicitly. " +
+				"For more information, please refer https://kubernetes.io/docs/concepts/services-networking/network-policies/#default-policies",
+		},
+	}
+}
+
+type networkPolicySelector struct {
+	networkingv1.NetworkPolicy
+	selector labels.Selector
+	ingress  bool
+	egress   bool
+}
+
+func networkPolicySelectorsOf(netpolList networkingv1.NetworkPolicyList) []networkPolicySelector {
+	result := []networkPolicySelector{}
+	for _, np := range netpolList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil {
+			continue
+		}
+		s := networkPolicySelector{NetworkPolicy: np, selector: selector}
+		for _, t := range np.Spec.PolicyTypes {
+			s.ingress = s.ingress || t == networkingv1.PolicyTypeIngress
+			s.egress = s.egress || t == networkingv1.PolicyTypeEgress
+		}
+		if len(np.Spec.PolicyTypes) == 0 {
+			// defaults (usually set by the API server)
+			s.ingress = true
+			s.egress = len(np.Spec.Egress) != 0
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+// isDefaultDeny returns whether the policy selects every pod and allows nothing, for ingress and egress.
+func (s networkPolicySelector) isDefaultDeny() (ingress, egress bool) {
+	if !s.selector.Empty() {
+		return false, false
+	}
+	return s.ingress && len(s.Spec.Ingress) == 0, s.egress && len(s.Spec.Egress) == 0
+}
+
+// allowsAll returns whether the policy has a rule allowing every traffic (e.g, "ingress: [{}]"), for ingress and egress.
+// pods selected by those policies are not isolated at all.
+func (s networkPolicySelector) allowsAll() (ingress, egress bool) {
+	if s.ingress {
+		for _, rule := range s.Spec.Ingress {
+			ingress = ingress || (len(rule.From) == 0 && len(rule.Ports) == 0)
+		}
+	}
+	if s.egress {
+		for _, rule := range s.Spec.Egress {
+			egress = egress || (len(rule.To) == 0 && len(rule.Ports) == 0)
+		}
+	}
+	return ingress, egress
+}
+
+// networkPolicyCoverage is NetworkPolicy coverage of pods in a namespace.
+type networkPolicyCoverage struct {
+	Namespace         string
+	Pods              int
+	IngressUnselected int
+	EgressUnselected  int
+	// pods selected by policies allowing every traffic, which are not isolated either
+	IngressAllowAll    int
+	EgressAllowAll     int
+	DefaultDenyIngress bool
+	DefaultDenyEgress  bool
+	AllowAllPolicies   []string
+	UnusedPolicies     []string
+}
+
+func (c networkPolicyCoverage) unisolated() int {
+	return c.IngressUnselected + c.EgressUnselected + c.IngressAllowAll + c.EgressAllowAll
+}
+
+// unisolatedPod is a pod not selected by any NetworkPolicy, or selected by policies allowing every traffic.
+type unisolatedPod struct {
+	Namespace string
+	Name      string
+	Workload  string
+	Ingress   bool
+	Egress    bool
+}
+
+// networkPolicyCoverageOf evaluates coverage of each namespace, and returns pods not isolated.
+func networkPolicyCoverageOf(pods []v1.Pod, policies []networkPolicySelector, excluded map[string]bool) ([]networkPolicyCoverage, []unisolatedPod) {
+	namespaces := make(map[string]*networkPolicyCoverage)
+	namespaceOf := func(name string) *networkPolicyCoverage {
+		ns, ok := namespaces[name]
+		if !ok {
+			ns = &networkPolicyCoverage{Namespace: name, AllowAllPolicies: []string{}, UnusedPolicies: []string{}}
+			namespaces[name] = ns
+		}
+		return ns
+	}
+	unisolatedPods := []unisolatedPod{}
+
+	used := make(map[string]bool)
+	for _, np := range policies {
+		if excluded[np.Namespace] {
+			continue
+		}
+		ns := namespaceOf(np.Namespace)
+		ingress, egress := np.isDefaultDeny()
+		ns.DefaultDenyIngress = ns.DefaultDenyIngress || ingress
+		ns.DefaultDenyEgress = ns.DefaultDenyEgress || egress
+		if ingress, egress := np.allowsAll(); ingress || egress {
+			ns.AllowAllPolicies = append(ns.AllowAllPolicies, np.Name)
+		}
+	}
+	for _, po := range pods {
+		// NetworkPolicies are not applied to pods in the host network
+		if excluded[po.Namespace] || po.Spec.HostNetwork ||
+			po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		ns := namespaceOf(po.Namespace)
+		ns.Pods++
+		ingress, egress := false, false
+		ingressAllowAll, egressAllowAll := false, false
+		for _, np := range policies {
+			if np.Namespace != po.Namespace || !np.selector.Matches(labels.Set(po.Labels)) {
+				continue
+			}
+			used[np.Namespace+"/"+np.Name] = true
+			ingress = ingress || np.ingress
+			egress = egress || np.egress
+			allowsIngress, allowsEgress := np.allowsAll()
+			ingressAllowAll = ingressAllowAll || allowsIngress
+			egressAllowAll = egressAllowAll || allowsEgress
+		}
+		switch {
+		case !ingress:
+			ns.IngressUnselected++
+		case ingressAllowAll:
+			ns.IngressAllowAll++
+		}
+		switch {
+		case !egress:
+			ns.EgressUnselected++
+		case egressAllowAll:
+			ns.EgressAllowAll++
+		}
+		isolatedIngress, isolatedEgress := ingress && !ingressAllowAll, egress && !egressAllowAll
+		if !isolatedIngress || !isolatedEgress {
+			unisolatedPods = append(unisolatedPods, unisolatedPod{
+				Namespace: po.Namespace,
+				Name:      po.Name,
+				Workload:  workloadOf(po),
+				Ingress:   !isolatedIngress,
+				Egress:    !isolatedEgress,
+			})
+		}
+	}
+	for _, np := range policies {
+		if excluded[np.Namespace] || used[np.Namespace+"/"+np.Name] {
+			continue
+		}
+		ns := namespaceOf(np.Namespace)
+		ns.UnusedPolicies = append(ns.UnusedPolicies, np.Name)
+	}
+
+	coverage := []networkPolicyCoverage{}
+	for _, ns := range namespaces {
+		coverage = append(coverage, *ns)
+	}
+	sort.Slice(coverage, func(i, j int) bool {
+		if a, b := coverage[i].unisolated(), coverage[j].unisolated(); a != b {
+			return a > b
+		}
+		return coverage[i].Namespace < coverage[j].Namespace
+	})
+	return coverage, unisolatedPods
+}
+
+// Do implements detek.Detector
+func (d *NetworkPolicyCoverage) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	netpolList, err := detek.Typing[networkingv1.NetworkPolicyList](
+		ctx.Get(collector.KeyK8sNetworkingV1NetworkPolicyList, nil))
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+
+	policies := networkPolicySelectorsOf(netpolList)
+	coverage, unisolatedPods := networkPolicyCoverageOf(podList.Items, policies, excluded)
+
+	hasPassed := true
+	for _, ns := range coverage {
+		if ns.unisolated() != 0 || len(ns.UnusedPolicies) != 0 ||
+			(ns.Pods != 0 && (!ns.DefaultDenyIngress || !ns.DefaultDenyEgress)) {
+			hasPassed = false
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: hasPassed,
+		Problem: detek.JSONableData{
+			Description: "NetworkPolicy coverage of each namespace",
+			Data:        coverage,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "Pods not isolated by NetworkPolicies (not selected, or selected by allow-all policies)", Data: unisolatedPods},
+			{Description: "# of evaluated NetworkPolicies", Data: len(policies)},
+		},
+	}, nil
+}
@@ -0,0 +1,220 @@
+package detector
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNetworkPolicy(name string, selector map[string]string, spec networkingv1.NetworkPolicySpec) networkingv1.NetworkPolicy {
+	spec.PodSelector = metav1.LabelSelector{MatchLabels: selector}
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       spec,
+	}
+}
+
+func newPolicyPod(name string, labels map[string]string, hostNetwork bool) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels},
+		Spec:       v1.PodSpec{HostNetwork: hostNetwork},
+		Status:     v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func TestNetworkPolicySelectorsOf(t *testing.T) {
+	egressRules := []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{}}}}
+	tests := []struct {
+		name        string
+		spec        networkingv1.NetworkPolicySpec
+		wantIngress bool
+		wantEgress  bool
+	}{
+		{
+			name:        "defaults without egress rules",
+			spec:        networkingv1.NetworkPolicySpec{},
+			wantIngress: true,
+			wantEgress:  false,
+		},
+		{
+			name:        "defaults with egress rules",
+			spec:        networkingv1.NetworkPolicySpec{Egress: egressRules},
+			wantIngress: true,
+			wantEgress:  true,
+		},
+		{
+			name:        "egress only",
+			spec:        networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+			wantIngress: false,
+			wantEgress:  true,
+		},
+		{
+			name: "both",
+			spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+			wantIngress: true,
+			wantEgress:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := networkPolicySelectorsOf(networkingv1.NetworkPolicyList{
+				Items: []networkingv1.NetworkPolicy{newNetworkPolicy("np", nil, tt.spec)},
+			})
+			if len(got) != 1 {
+				t.Fatalf("networkPolicySelectorsOf() returned %d policies, want 1", len(got))
+			}
+			if got[0].ingress != tt.wantIngress || got[0].egress != tt.wantEgress {
+				t.Errorf("networkPolicySelectorsOf() = (ingress: %v, egress: %v), want (ingress: %v, egress: %v)",
+					got[0].ingress, got[0].egress, tt.wantIngress, tt.wantEgress)
+			}
+		})
+	}
+}
+
+func TestNetworkPolicySelector_isDefaultDeny(t *testing.T) {
+	tests := []struct {
+		name        string
+		selector    map[string]string
+		spec        networkingv1.NetworkPolicySpec
+		wantIngress bool
+		wantEgress  bool
+	}{
+		{
+			name:        "ingress default deny",
+			spec:        networkingv1.NetworkPolicySpec{},
+			wantIngress: true,
+			wantEgress:  false,
+		},
+		{
+			name:        "egress only default deny",
+			spec:        networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress}},
+			wantIngress: false,
+			wantEgress:  true,
+		},
+		{
+			name: "both default deny",
+			spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+			wantIngress: true,
+			wantEgress:  true,
+		},
+		{
+			name:        "not every pod",
+			selector:    map[string]string{"app": "web"},
+			spec:        networkingv1.NetworkPolicySpec{},
+			wantIngress: false,
+			wantEgress:  false,
+		},
+		{
+			name:        "allowing some traffic",
+			spec:        networkingv1.NetworkPolicySpec{Ingress: []networkingv1.NetworkPolicyIngressRule{{Ports: []networkingv1.NetworkPolicyPort{{}}}}},
+			wantIngress: false,
+			wantEgress:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := networkPolicySelectorsOf(networkingv1.NetworkPolicyList{
+				Items: []networkingv1.NetworkPolicy{newNetworkPolicy("np", tt.selector, tt.spec)},
+			})
+			ingress, egress := policies[0].isDefaultDeny()
+			if ingress != tt.wantIngress || egress != tt.wantEgress {
+				t.Errorf("isDefaultDeny() = (%v, %v), want (%v, %v)", ingress, egress, tt.wantIngress, tt.wantEgress)
+			}
+		})
+	}
+}
+
+func TestNetworkPolicyCoverageOf(t *testing.T) {
+	web := map[string]string{"app": "web"}
+	denyAll := newNetworkPolicy("deny-all", nil, networkingv1.NetworkPolicySpec{
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+	})
+
+	tests := []struct {
+		name           string
+		pods           []v1.Pod
+		policies       []networkingv1.NetworkPolicy
+		want           networkPolicyCoverage
+		wantUnisolated []unisolatedPod
+	}{
+		{
+			name:     "isolated by default deny",
+			pods:     []v1.Pod{newPolicyPod("web", web, false)},
+			policies: []networkingv1.NetworkPolicy{denyAll},
+			want: networkPolicyCoverage{
+				Namespace: "default", Pods: 1, DefaultDenyIngress: true, DefaultDenyEgress: true,
+				AllowAllPolicies: []string{}, UnusedPolicies: []string{},
+			},
+			wantUnisolated: []unisolatedPod{},
+		},
+		{
+			name: "no policies",
+			pods: []v1.Pod{newPolicyPod("web", web, false)},
+			want: networkPolicyCoverage{
+				Namespace: "default", Pods: 1, IngressUnselected: 1, EgressUnselected: 1,
+				AllowAllPolicies: []string{}, UnusedPolicies: []string{},
+			},
+			wantUnisolated: []unisolatedPod{{Namespace: "default", Name: "web", Workload: "(none)", Ingress: true, Egress: true}},
+		},
+		{
+			name: "selector matching nothing",
+			pods: []v1.Pod{newPolicyPod("web", web, false)},
+			policies: []networkingv1.NetworkPolicy{
+				denyAll,
+				newNetworkPolicy("db", map[string]string{"app": "db"}, networkingv1.NetworkPolicySpec{}),
+			},
+			want: networkPolicyCoverage{
+				Namespace: "default", Pods: 1, DefaultDenyIngress: true, DefaultDenyEgress: true,
+				AllowAllPolicies: []string{}, UnusedPolicies: []string{"db"},
+			},
+			wantUnisolated: []unisolatedPod{},
+		},
+		{
+			name:     "host network pods are skipped",
+			pods:     []v1.Pod{newPolicyPod("web", web, false), newPolicyPod("node-agent", nil, true)},
+			policies: []networkingv1.NetworkPolicy{denyAll},
+			want: networkPolicyCoverage{
+				Namespace: "default", Pods: 1, DefaultDenyIngress: true, DefaultDenyEgress: true,
+				AllowAllPolicies: []string{}, UnusedPolicies: []string{},
+			},
+			wantUnisolated: []unisolatedPod{},
+		},
+		{
+			name: "allow-all is not coverage",
+			pods: []v1.Pod{newPolicyPod("web", web, false)},
+			policies: []networkingv1.NetworkPolicy{
+				denyAll,
+				newNetworkPolicy("allow-all", web, networkingv1.NetworkPolicySpec{
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{}},
+				}),
+			},
+			want: networkPolicyCoverage{
+				Namespace: "default", Pods: 1, IngressAllowAll: 1, DefaultDenyIngress: true, DefaultDenyEgress: true,
+				AllowAllPolicies: []string{"allow-all"}, UnusedPolicies: []string{},
+			},
+			wantUnisolated: []unisolatedPod{{Namespace: "default", Name: "web", Workload: "(none)", Ingress: true, Egress: false}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policies := networkPolicySelectorsOf(networkingv1.NetworkPolicyList{Items: tt.policies})
+			coverage, unisolated := networkPolicyCoverageOf(tt.pods, policies, map[string]bool{})
+			if len(coverage) != 1 {
+				t.Fatalf("networkPolicyCoverageOf() returned %d namespaces, want 1", len(coverage))
+			}
+			if !reflect.DeepEqual(coverage[0], tt.want) {
+				t.Errorf("networkPolicyCoverageOf() coverage = %+v, want %+v", coverage[0], tt.want)
+			}
+			if !reflect.DeepEqual(unisolated, tt.wantUnisolated) {
+				t.Errorf("networkPolicyCoverageOf() unisolated = %+v, want %+v", unisolated, tt.wantUnisolated)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ detek.Detector = &NodeResourceCommitment{}
+
+type NodeResourceCommitment struct {
+	// nodes whose limits exceed allocatable by this factor will be reported. (default: 1.5)
+	LimitOvercommitFactor float64
+}
+
+func (d *NodeResourceCommitment) limitOvercommitFactor() float64 {
+	if d.LimitOvercommitFactor == 0 {
+		return 1.5
+	}
+	return d.LimitOvercommitFactor
+}
+
+// GetMeta implements detek.Detector
+func (d *NodeResourceCommitment) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "node_resource_commitment",
+			Description: "Evaluating requests and limits committed on nodes, and headroom for pending pods",
+			Labels:      []string{"kubernetes", "node", "pod", "capacity"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList: {Type: detek.TypeOf(v1.NodeList{})},
+			collector.KeyK8sCoreV1PodList:  {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of nodes are at risk of memory eviction (a single pod using memory up to its limit exceeds the allocatable memory, or pods have no memory limits), " +
+				"or no node has enough room for some of pending pods.",
+			Solution: "Set memory limits on every pod, and memory requests closer to limits (or equal, for Guaranteed QoS). " +
+				"Add nodes (or reduce requests) so that pending pods can be scheduled.",
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of nodes have limits exceeding their allocatable resources by %.1fx or more. "+
+					"Those nodes will be under pressure when pods use resources up to their limits.", d.limitOvercommitFactor()),
+				Solution: "Lower limits (or raise requests) of pods on those nodes, or spread them to the other nodes.",
+			},
+		},
+	}
+}
+
+type nodeCommitment struct {
+	Unschedulable bool
+	Allocatable   v1.ResourceList
+	Requests      v1.ResourceList
+	Limits        v1.ResourceList
+	// the largest (memory limit - memory request) of a single pod
+	MaxMemoryBurst resource.Quantity
+	MaxBurstPod    string
+	// pods without memory limits, which can use memory up to the allocatable
+	UnlimitedMemoryPods []string
+}
+
+// Do implements detek.Detector
+func (d *NodeResourceCommitment) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := map[string]*nodeCommitment{}
+	for _, no := range nodeList.Items {
+		nodes[no.Name] = &nodeCommitment{
+			Unschedulable: no.Spec.Unschedulable,
+			Allocatable:   no.Status.Allocatable,
+			Requests:      v1.ResourceList{},
+			Limits:        v1.ResourceList{},
+		}
+	}
+	type pendingPod struct {
+		name     string
+		requests v1.ResourceList
+	}
+	pendingPods := []pendingPod{}
+	for _, po := range podList.Items {
+		requests, limits := podResourcesOf(po)
+		if isUnscheduledPod(po) {
+			pendingPods = append(pendingPods, pendingPod{po.Namespace + "/" + po.Name, requests})
+			continue
+		}
+		n, ok := nodes[po.Spec.NodeName]
+		if !ok || !isActivePod(po) {
+			continue
+		}
+		addResources(n.Requests, requests)
+		addResources(n.Limits, limits)
+		if !hasLimit(po, v1.ResourceMemory) {
+			n.UnlimitedMemoryPods = append(n.UnlimitedMemoryPods, po.Namespace+"/"+po.Name)
+		} else if limit, ok := limits[v1.ResourceMemory]; ok {
+			burst := limit.DeepCopy()
+			burst.Sub(requests[v1.ResourceMemory])
+			if burst.Cmp(n.MaxMemoryBurst) > 0 {
+				n.MaxMemoryBurst = burst
+				n.MaxBurstPod = po.Namespace + "/" + po.Name
+			}
+		}
+	}
+
+	type Commitment struct {
+		Name           string
+		CPURequests    string
+		CPULimits      string
+		MemoryRequests string
+		MemoryLimits   string
+	}
+	type Problem struct {
+		Node   string
+		Pod    string `json:",omitempty"`
+		Reason string
+		Detail string
+		Level  detek.SeverityLevel
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+	report := func(p Problem) {
+		if p.Level.ToInt() > observed.ToInt() {
+			observed = p.Level
+		}
+		problems = append(problems, p)
+	}
+
+	names := []string{}
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	commitments := []Commitment{}
+	total := &nodeCommitment{Allocatable: v1.ResourceList{}, Requests: v1.ResourceList{}, Limits: v1.ResourceList{}}
+	for _, name := range names {
+		n := nodes[name]
+		addResources(total.Allocatable, n.Allocatable)
+		addResources(total.Requests, n.Requests)
+		addResources(total.Limits, n.Limits)
+		commitments = append(commitments, Commitment{
+			Name:           name,
+			CPURequests:    percentOf(n.Requests[v1.ResourceCPU], n.Allocatable[v1.ResourceCPU]),
+			CPULimits:      percentOf(n.Limits[v1.ResourceCPU], n.Allocatable[v1.ResourceCPU]),
+			MemoryRequests: percentOf(n.Requests[v1.ResourceMemory], n.Allocatable[v1.ResourceMemory]),
+			MemoryLimits:   percentOf(n.Limits[v1.ResourceMemory], n.Allocatable[v1.ResourceMemory]),
+		})
+
+		for _, res := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			if ratio := ratioOf(n.Limits[res], n.Allocatable[res]); ratio >= d.limitOvercommitFactor() {
+				report(Problem{
+					Node:   name,
+					Reason: fmt.Sprintf("%s limits overcommitted", res),
+					Detail: fmt.Sprintf("limits are %.1fx of allocatable (%s / %s)", ratio, quantityText(n.Limits[res]), quantityText(n.Allocatable[res])),
+					Level:  detek.Warn,
+				})
+			}
+		}
+
+		// a single pod bursting up to its memory limit exceeds the allocatable memory
+		allocatable := n.Allocatable[v1.ResourceMemory]
+		burst := n.Requests[v1.ResourceMemory].DeepCopy()
+		burst.Add(n.MaxMemoryBurst)
+		if !allocatable.IsZero() && !n.MaxMemoryBurst.IsZero() && burst.Cmp(allocatable) > 0 {
+			report(Problem{
+				Node:   name,
+				Reason: "memory eviction risk",
+				Detail: fmt.Sprintf("requests (%s) + memory burst of %s (%s) exceed allocatable (%s)",
+					quantityText(n.Requests[v1.ResourceMemory]), n.MaxBurstPod, n.MaxMemoryBurst.String(), allocatable.String()),
+				Level: detek.Error,
+			})
+		}
+		if len(n.UnlimitedMemoryPods) != 0 {
+			report(Problem{
+				Node:   name,
+				Reason: "memory eviction risk",
+				Detail: fmt.Sprintf("%d pods without memory limits can use memory up to allocatable (%s): %s",
+					len(n.UnlimitedMemoryPods), allocatable.String(), strings.Join(n.UnlimitedMemoryPods, ", ")),
+				Level: detek.Error,
+			})
+		}
+	}
+
+	// the largest pending pod, by memory (and cpu) requests
+	type PendingPod struct {
+		Name         string
+		CPU          string
+		Memory       string
+		FittingNodes int
+	}
+	var largest *PendingPod
+	var largestRequests v1.ResourceList
+	for _, po := range pendingPods {
+		fittingNodes := 0
+		for _, n := range nodes {
+			if !n.Unschedulable && fits(n, po.requests) {
+				fittingNodes++
+			}
+		}
+		if fittingNodes == 0 {
+			report(Problem{
+				Node:   "(none)",
+				Pod:    po.name,
+				Reason: "no headroom for pending pod",
+				Detail: fmt.Sprintf("no node has room for requests of the pod (cpu: %s, memory: %s)",
+					quantityText(po.requests[v1.ResourceCPU]), quantityText(po.requests[v1.ResourceMemory])),
+				Level: detek.Error,
+			})
+		}
+		if largest == nil || isLargerRequests(po.requests, largestRequests) {
+			largestRequests = po.requests
+			largest = &PendingPod{
+				Name:         po.name,
+				CPU:          quantityText(po.requests[v1.ResourceCPU]),
+				Memory:       quantityText(po.requests[v1.ResourceMemory]),
+				FittingNodes: fittingNodes,
+			}
+		}
+	}
+
+	cluster := Commitment{
+		Name:           "(cluster)",
+		CPURequests:    percentOf(total.Requests[v1.ResourceCPU], total.Allocatable[v1.ResourceCPU]),
+		CPULimits:      percentOf(total.Limits[v1.ResourceCPU], total.Allocatable[v1.ResourceCPU]),
+		MemoryRequests: percentOf(total.Requests[v1.ResourceMemory], total.Allocatable[v1.ResourceMemory]),
+		MemoryLimits:   percentOf(total.Limits[v1.ResourceMemory], total.Allocatable[v1.ResourceMemory]),
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Nodes with overcommitted resources",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "cluster-wide commitment (of allocatable)", Data: cluster},
+			{Description: "commitment per node (of allocatable)", Data: commitments},
+			{Description: "# of pending Pods", Data: len(pendingPods)},
+			{Description: "largest pending Pod", Data: largest},
+		},
+	}, nil
+}
+
+// fits returns whether the node has room for the given requests. (taints and affinities are not considered)
+func fits(n *nodeCommitment, requests v1.ResourceList) bool {
+	for res, req := range requests {
+		free := n.Allocatable[res].DeepCopy()
+		free.Sub(n.Requests[res])
+		if free.Cmp(req) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isLargerRequests compares requests by memory, and then by cpu.
+func isLargerRequests(a, b v1.ResourceList) bool {
+	if c := a.Memory().Cmp(*b.Memory()); c != 0 {
+		return c > 0
+	}
+	return a.Cpu().Cmp(*b.Cpu()) > 0
+}
+
+// quantityText is for quantities in a ResourceList, which are not addressable.
+func quantityText(q resource.Quantity) string {
+	return q.String()
+}
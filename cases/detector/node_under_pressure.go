@@ -0,0 +1,86 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &NodeUnderPressure{}
+
+type NodeUnderPressure struct {
+	Threshold RatioThreshold
+}
+
+// GetMeta implements detek.Detector
+func (d *NodeUnderPressure) GetMeta() detek.DetectorInfo {
+	ifHappened := detek.Description{
+		Explanation: "Some of nodes are under resource pressure or have network problems. " +
+			"Kubelet may evict pods, and new pods will not be scheduled on those nodes.",
+		Solution: "Check resource usages of those nodes, and clean up disks or move workloads to the other nodes.",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "node_under_pressure",
+			Description: "Finding nodes with MemoryPressure, DiskPressure, PIDPressure or NetworkUnavailable condition",
+			Labels:      []string{"kubernetes", "node"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList: {Type: detek.TypeOf(v1.NodeList{})},
+		},
+		Level:            detek.Fatal,
+		IfHappened:       ifHappened,
+		LevelDescription: d.Threshold.Describe(ifHappened),
+	}
+}
+
+// Do implements detek.Detector
+func (d *NodeUnderPressure) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name      string
+		Condition string
+		Reason    string
+		Message   string
+	}
+	problems := []Problem{}
+	affected := make(map[string]bool)
+
+	for _, no := range nodeList.Items {
+		for _, cond := range no.Status.Conditions {
+			switch cond.Type {
+			case v1.NodeMemoryPressure, v1.NodeDiskPressure, v1.NodePIDPressure, v1.NodeNetworkUnavailable:
+			default:
+				continue
+			}
+			if cond.Status != v1.ConditionTrue {
+				continue
+			}
+			affected[no.Name] = true
+			problems = append(problems, Problem{
+				Name:      no.Name,
+				Condition: string(cond.Type),
+				Reason:    cond.Reason,
+				Message:   cond.Message,
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: d.Threshold.LevelOf(len(affected), len(nodeList.Items)),
+		Problem: detek.JSONableData{
+			Description: "Nodes under pressure",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Nodes", Data: len(nodeList.Items)},
+			{Description: "# of affected Nodes", Data: len(affected)},
+		},
+	}, nil
+}
@@ -0,0 +1,91 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &NotReadyNode{}
+
+type NotReadyNode struct {
+	Threshold RatioThreshold
+}
+
+// GetMeta implements detek.Detector
+func (d *NotReadyNode) GetMeta() detek.DetectorInfo {
+	ifHappened := detek.Description{
+		Explanation: "Some of nodes are not ready. Pods on those nodes may not be working, and new pods can not be scheduled on them.",
+		Solution:    "Check kubelet, container runtime and network of those nodes. (e.g, `kubectl describe node <name>`, `journalctl -u kubelet`)",
+	}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "not_ready_node",
+			Description: "Finding nodes which are in a 'NotReady' or 'Unknown' status",
+			Labels:      []string{"kubernetes", "node"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NodeList: {Type: detek.TypeOf(v1.NodeList{})},
+		},
+		Level:            detek.Fatal,
+		IfHappened:       ifHappened,
+		LevelDescription: d.Threshold.Describe(ifHappened),
+	}
+}
+
+// Do implements detek.Detector
+func (d *NotReadyNode) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name    string
+		Status  string
+		Reason  string
+		Message string
+		Since   string
+	}
+	problems := []Problem{}
+
+	for _, no := range nodeList.Items {
+		p := Problem{
+			Name:   no.Name,
+			Status: string(v1.ConditionUnknown),
+			Reason: "detek: Ready condition not found",
+		}
+		for _, cond := range no.Status.Conditions {
+			if cond.Type != v1.NodeReady {
+				continue
+			}
+			p.Status = string(cond.Status)
+			p.Reason = cond.Reason
+			p.Message = cond.Message
+			if !cond.LastTransitionTime.IsZero() {
+				p.Since = fmt.Sprintf("%s (%s ago)",
+					cond.LastTransitionTime.UTC().Format(time.RFC3339),
+					time.Since(cond.LastTransitionTime.Time).Round(time.Second))
+			}
+		}
+		if p.Status != string(v1.ConditionTrue) {
+			problems = append(problems, p)
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: d.Threshold.LevelOf(len(problems), len(nodeList.Items)),
+		Problem: detek.JSONableData{
+			Description: "Nodes not ready",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Nodes", Data: len(nodeList.Items)},
+		},
+	}, nil
+}
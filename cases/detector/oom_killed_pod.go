@@ -0,0 +1,59 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &OOMKilledPod{}
+
+type OOMKilledPod struct{}
+
+// GetMeta implements detek.Detector
+func (*OOMKilledPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "oom_killed_pod",
+			Description: "Finding containers whose last termination reason is 'OOMKilled'",
+			Labels:      []string{"kubernetes", "pod", "container", "memory"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of containers were killed, since they used more memory than their limits (or the node ran out of memory).",
+			Solution:    "Check memory usage of those containers, and raise memory limits or fix memory leaks.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*OOMKilledPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	problems := []containerProblem{}
+	for _, po := range podList.Items {
+		for _, cs := range containerStatusesOf(po) {
+			if term := lastTerminated(cs); term != nil && term.Reason == "OOMKilled" {
+				problems = append(problems, newContainerProblem(po, cs, term.Reason))
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "OOMKilled containers",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
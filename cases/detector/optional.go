@@ -0,0 +1,13 @@
+package detector
+
+import "github.com/kakao/detek/pkg/detek"
+
+// optionalList returns a list stored with a given key, or an empty list if it is not collected.
+// (for dependencies marked as IsOptional)
+func optionalList[T any](ctx detek.DetekContext, key string) (T, error) {
+	list, err := detek.Typing[T](ctx.Get(key, nil))
+	if detek.IsKeyNotFound(err) {
+		return list, nil
+	}
+	return list, err
+}
@@ -0,0 +1,152 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ detek.Detector = &OverRequestedWorkload{}
+
+type OverRequestedWorkload struct {
+	// workloads using less than this ratio of requests will be reported. (default: 0.2)
+	MinUsageRatio float64
+	// workloads whose requests per pod are smaller than these are not evaluated, since wasted capacity is negligible. (default: 200m, 256Mi)
+	MinCPURequests    resource.Quantity
+	MinMemoryRequests resource.Quantity
+}
+
+func (d *OverRequestedWorkload) minUsageRatio() float64 {
+	if d.MinUsageRatio == 0 {
+		return 0.2
+	}
+	return d.MinUsageRatio
+}
+
+func (d *OverRequestedWorkload) minRequestsOf(res v1.ResourceName) resource.Quantity {
+	switch {
+	case res == v1.ResourceCPU && d.MinCPURequests.IsZero():
+		return resource.MustParse("200m")
+	case res == v1.ResourceCPU:
+		return d.MinCPURequests
+	case d.MinMemoryRequests.IsZero():
+		return resource.MustParse("256Mi")
+	default:
+		return d.MinMemoryRequests
+	}
+}
+
+// GetMeta implements detek.Detector
+func (d *OverRequestedWorkload) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "over_requested_workload",
+			Description: fmt.Sprintf("Finding workloads using less than %.0f%% of requested cpu or memory", d.minUsageRatio()*100),
+			Labels:      []string{"kubernetes", "pod", "metrics", "capacity"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:            {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sMetricsV1Beta1PodMetrics: {Type: detek.TypeOf([]collector.PodMetrics{}), IsOptional: true},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads request far more resources than they use. Requested resources are reserved on nodes, so the capacity of the cluster is wasted.",
+			Solution: "Lower requests of those workloads, based on their usages over a longer period (e.g, with a VerticalPodAutoscaler in a recommendation mode). " +
+				"Note that usages are a snapshot at the moment of evaluation.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *OverRequestedWorkload) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podMetrics, ok, err := loadPodMetrics(ctx)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return metricsUnavailable(), nil
+	}
+
+	type Problem struct {
+		Namespace string
+		Workload  string
+		Pods      int
+		Resource  v1.ResourceName
+		Requests  string
+		Usage     string
+		Ratio     string
+	}
+	type workload struct {
+		namespace, name string
+		pods            int
+		requests, usage v1.ResourceList
+	}
+	workloads := []*workload{}
+	index := map[string]*workload{}
+
+	for _, po := range podList.Items {
+		m, ok := podMetrics[po.Namespace+"/"+po.Name]
+		if !ok || po.Status.Phase != v1.PodRunning {
+			continue
+		}
+		name := workloadOf(po)
+		if name == "(none)" {
+			name = "Pod/" + po.Name
+		}
+		key := po.Namespace + "/" + name
+		w, ok := index[key]
+		if !ok {
+			w = &workload{namespace: po.Namespace, name: name, requests: v1.ResourceList{}, usage: v1.ResourceList{}}
+			index[key] = w
+			workloads = append(workloads, w)
+		}
+		w.pods++
+		// usages of init containers are not reported, compare regular containers only
+		for _, co := range po.Spec.Containers {
+			addResources(w.requests, co.Resources.Requests)
+		}
+		for _, co := range m.Containers {
+			addResources(w.usage, co.Usage)
+		}
+	}
+
+	problems := []Problem{}
+	for _, w := range workloads {
+		for _, res := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			requests, usage := w.requests[res], w.usage[res]
+			perPod := resource.NewMilliQuantity(requests.MilliValue()/int64(w.pods), requests.Format)
+			if minRequests := d.minRequestsOf(res); perPod.Cmp(minRequests) < 0 {
+				continue
+			}
+			if ratio := ratioOf(usage, requests); ratio < d.minUsageRatio() {
+				problems = append(problems, Problem{
+					Namespace: w.namespace,
+					Workload:  w.name,
+					Pods:      w.pods,
+					Resource:  res,
+					Requests:  requests.String(),
+					Usage:     usage.String(),
+					Ratio:     percentOf(usage, requests),
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Workloads using far less than requested",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated workloads", Data: len(workloads)},
+		},
+	}, nil
+}
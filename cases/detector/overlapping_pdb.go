@@ -0,0 +1,95 @@
+package detector
+
+import (
+	"strings"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+var _ detek.Detector = &OverlappingPDB{}
+
+type OverlappingPDB struct{}
+
+// GetMeta implements detek.Detector
+func (*OverlappingPDB) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "overlapping_pdb",
+			Description: "Finding pods selected by more than one PodDisruptionBudget",
+			Labels:      []string{"kubernetes", "pdb", "pod"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:                   {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sPolicyV1PodDisruptionBudgetList: {Type: detek.TypeOf(policyv1.PodDisruptionBudgetList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods are selected by more than one PodDisruptionBudget. The eviction API refuses to evict those pods, so draining their nodes will be blocked.",
+			Solution:    "Fix selectors of those PodDisruptionBudgets, so that each pod is selected by at most one PodDisruptionBudget.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*OverlappingPDB) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pdbList, err := detek.Typing[policyv1.PodDisruptionBudgetList](
+		ctx.Get(collector.KeyK8sPolicyV1PodDisruptionBudgetList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Workload  string
+		PDBs      string
+		Pods      int
+	}
+	// aggregated by (namespace, workload, PDBs), since pods of a workload share the same labels
+	problems := []*Problem{}
+	index := map[string]*Problem{}
+
+	pdbs := pdbSelectorsOf(pdbList)
+	for _, po := range podList.Items {
+		names := pdbsOf(pdbs, po)
+		if len(names) < 2 {
+			continue
+		}
+		workload := workloadOf(po)
+		if workload == "(none)" {
+			workload = "Pod/" + po.Name
+		}
+		p := &Problem{
+			Namespace: po.Namespace,
+			Workload:  workload,
+			PDBs:      strings.Join(names, ","),
+		}
+		key := strings.Join([]string{p.Namespace, p.Workload, p.PDBs}, "/")
+		if found, ok := index[key]; ok {
+			found.Pods++
+			continue
+		}
+		p.Pods = 1
+		index[key] = p
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Pods selected by multiple PodDisruptionBudgets",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+			{Description: "# of PodDisruptionBudgets", Data: len(pdbList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,94 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+var _ detek.Detector = &PDBBlockingDrain{}
+
+type PDBBlockingDrain struct{}
+
+// GetMeta implements detek.Detector
+func (*PDBBlockingDrain) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pdb_blocking_drain",
+			Description: "Finding PodDisruptionBudgets which allow no disruptions",
+			Labels:      []string{"kubernetes", "pdb", "upgrade"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sPolicyV1PodDisruptionBudgetList: {Type: detek.TypeOf(policyv1.PodDisruptionBudgetList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of PodDisruptionBudgets allow no disruptions. Draining nodes running those pods (e.g, during upgrades) will be blocked.",
+			Solution: "Relax minAvailable (or maxUnavailable) of those PodDisruptionBudgets, scale out the workloads, or fix unhealthy pods before draining nodes. " +
+				"For more information, please refer https://kubernetes.io/docs/tasks/run-application/configure-pdb/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*PDBBlockingDrain) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	pdbList, err := detek.Typing[policyv1.PodDisruptionBudgetList](
+		ctx.Get(collector.KeyK8sPolicyV1PodDisruptionBudgetList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace      string
+		Name           string
+		MinAvailable   string `json:",omitempty"`
+		MaxUnavailable string `json:",omitempty"`
+		CurrentHealthy int32
+		DesiredHealthy int32
+		ExpectedPods   int32
+		Reason         string
+	}
+	problems := []Problem{}
+
+	for _, pdb := range pdbList.Items {
+		st := pdb.Status
+		if st.DisruptionsAllowed > 0 || st.ExpectedPods == 0 {
+			continue
+		}
+		p := Problem{
+			Namespace:      pdb.Namespace,
+			Name:           pdb.Name,
+			CurrentHealthy: st.CurrentHealthy,
+			DesiredHealthy: st.DesiredHealthy,
+			ExpectedPods:   st.ExpectedPods,
+		}
+		if v := pdb.Spec.MinAvailable; v != nil {
+			p.MinAvailable = v.String()
+		}
+		if v := pdb.Spec.MaxUnavailable; v != nil {
+			p.MaxUnavailable = v.String()
+		}
+		switch {
+		case st.CurrentHealthy < st.DesiredHealthy:
+			p.Reason = fmt.Sprintf("only %d of %d pods are healthy (desired: %d)", st.CurrentHealthy, st.ExpectedPods, st.DesiredHealthy)
+		case p.MaxUnavailable != "":
+			p.Reason = "maxUnavailable does not allow any unavailable pod"
+		default:
+			p.Reason = "minAvailable requires every pod to be available"
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "PodDisruptionBudgets blocking node drains",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated PodDisruptionBudgets", Data: len(pdbList.Items)},
+		},
+	}, nil
+}
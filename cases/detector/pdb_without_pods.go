@@ -0,0 +1,87 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ detek.Detector = &PDBWithoutPods{}
+
+type PDBWithoutPods struct{}
+
+// GetMeta implements detek.Detector
+func (*PDBWithoutPods) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pdb_without_pods",
+			Description: "Finding PodDisruptionBudgets selecting no pods",
+			Labels:      []string{"kubernetes", "pdb"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:                   {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sPolicyV1PodDisruptionBudgetList: {Type: detek.TypeOf(policyv1.PodDisruptionBudgetList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of PodDisruptionBudgets select no pods. Their workloads may be removed, or selectors may be misconfigured and protect nothing.",
+			Solution:    "Check selectors of those PodDisruptionBudgets match labels of pods, or delete PodDisruptionBudgets which are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*PDBWithoutPods) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pdbList, err := detek.Typing[policyv1.PodDisruptionBudgetList](
+		ctx.Get(collector.KeyK8sPolicyV1PodDisruptionBudgetList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Selector  string
+	}
+	problems := []Problem{}
+
+	selected := map[string]bool{}
+	pdbs := pdbSelectorsOf(pdbList)
+	for _, po := range podList.Items {
+		for _, name := range pdbsOf(pdbs, po) {
+			selected[po.Namespace+"/"+name] = true
+		}
+	}
+	for _, pdb := range pdbList.Items {
+		if selected[pdb.Namespace+"/"+pdb.Name] {
+			continue
+		}
+		selector := "(null)"
+		if pdb.Spec.Selector != nil {
+			selector = metav1.FormatLabelSelector(pdb.Spec.Selector)
+		}
+		problems = append(problems, Problem{
+			Namespace: pdb.Namespace,
+			Name:      pdb.Name,
+			Selector:  selector,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "PodDisruptionBudgets selecting no pods",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated PodDisruptionBudgets", Data: len(pdbList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,184 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &PendingPod{}
+
+type PendingPod struct {
+	// pods pending longer than this will be reported. (default: 10m)
+	MaxPendingDuration time.Duration
+}
+
+func (d *PendingPod) maxPendingDuration() time.Duration {
+	if d.MaxPendingDuration == 0 {
+		return 10 * time.Minute
+	}
+	return d.MaxPendingDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *PendingPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pending_pod",
+			Description: fmt.Sprintf("Finding pods stuck in a 'Pending' status longer than %s, with reasons from scheduling events", d.maxPendingDuration()),
+			Labels:      []string{"kubernetes", "pod", "scheduling"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:   {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sCoreV1EventList: {Type: detek.TypeOf(v1.EventList{}), IsOptional: true},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods are not running for a long time. Most of them can not be scheduled on any node.",
+			Solution: "Check the reason of each pod. " +
+				"e.g, add nodes or lower requests for insufficient resources, add tolerations for taints, " +
+				"or check StorageClass and PersistentVolumes for unbound PersistentVolumeClaims.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *PendingPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	// if events are not available, categorize with pod conditions only
+	eventList, err := optionalList[v1.EventList](ctx, collector.KeyK8sCoreV1EventList)
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest "FailedScheduling" event of each pod
+	schedulingEvents := make(map[types.UID]v1.Event)
+	for _, ev := range eventList.Items {
+		if ev.Reason != "FailedScheduling" || ev.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		if prev, ok := schedulingEvents[ev.InvolvedObject.UID]; ok && collector.LastSeenOf(prev).After(collector.LastSeenOf(ev)) {
+			continue
+		}
+		schedulingEvents[ev.InvolvedObject.UID] = ev
+	}
+
+	type Problem struct {
+		Namespace    string
+		Name         string
+		Workload     string
+		PendingFor   string
+		Scheduled    bool
+		Categories   []string
+		Reason       string
+		EventCount   int32  `json:",omitempty"`
+		EventMessage string `json:",omitempty"`
+	}
+	problems := []Problem{}
+	pending := 0
+
+	for _, po := range podList.Items {
+		if po.Status.Phase != v1.PodPending {
+			continue
+		}
+		pending++
+		duration := time.Since(po.CreationTimestamp.Time)
+		if duration < d.maxPendingDuration() {
+			continue
+		}
+
+		p := Problem{
+			Namespace:  po.Namespace,
+			Name:       po.Name,
+			Workload:   workloadOf(po),
+			PendingFor: duration.Round(time.Second).String(),
+			Scheduled:  true,
+		}
+		messages := []string{}
+		for _, cond := range po.Status.Conditions {
+			if cond.Type == v1.PodScheduled && cond.Status == v1.ConditionFalse {
+				p.Scheduled = false
+				p.Reason = strings.TrimSpace(fmt.Sprintf("%s %s", cond.Reason, cond.Message))
+				messages = append(messages, cond.Message)
+			}
+		}
+		if ev, ok := schedulingEvents[po.UID]; ok {
+			p.EventCount = ev.Count
+			if ev.Series != nil {
+				p.EventCount = ev.Series.Count
+			}
+			p.EventMessage = ev.Message
+			messages = append(messages, ev.Message)
+		}
+		if p.Scheduled {
+			// scheduled, but containers are not started yet
+			for _, cs := range containerStatusesOf(po) {
+				if waiting := cs.State.Waiting; waiting != nil {
+					p.Reason = fmt.Sprintf("container %q is waiting: %s %s", cs.Name, waiting.Reason, waiting.Message)
+					messages = append(messages, waiting.Reason)
+					break
+				}
+			}
+		}
+		p.Categories = categorizeSchedulingFailure(messages)
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Pods pending for a long time",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+			{Description: "# of pending Pods", Data: pending},
+			{Description: "# of evaluated FailedScheduling Events", Data: len(schedulingEvents)},
+		},
+	}, nil
+}
+
+// categorizeSchedulingFailure finds well-known reasons from messages of the scheduler.
+func categorizeSchedulingFailure(messages []string) []string {
+	patterns := map[string][]string{
+		"insufficient cpu":         {"insufficient cpu"},
+		"insufficient memory":      {"insufficient memory"},
+		"insufficient resources":   {"insufficient ephemeral-storage", "insufficient pods", "too many pods"},
+		"taints":                   {"untolerated taint", "had taint", "had taints"},
+		"node affinity / selector": {"didn't match pod's node affinity", "didn't match node selector"},
+		"pod (anti-)affinity":      {"didn't match pod affinity", "didn't match pod anti-affinity", "didn't satisfy existing pods anti-affinity"},
+		"topology spread":          {"didn't match pod topology spread constraints"},
+		"persistent volume claim":  {"persistentvolumeclaim", "unbound immediate persistentvolumeclaims", "volume node affinity conflict", "volume binding", "no persistent volumes available"},
+		"host port":                {"didn't have free ports"},
+		"unschedulable node":       {"were unschedulable"},
+		"image pull":               {"imagepullbackoff", "errimagepull"},
+		"container creating":       {"containercreating", "podinitializing"},
+	}
+	found := make(map[string]bool)
+	for _, msg := range messages {
+		msg = strings.ToLower(msg)
+		for category, keywords := range patterns {
+			for _, keyword := range keywords {
+				if strings.Contains(msg, keyword) {
+					found[category] = true
+				}
+			}
+		}
+	}
+	result := []string{}
+	for category := range found {
+		result = append(result, category)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,141 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &PendingPVC{}
+
+type PendingPVC struct {
+	// claims pending longer than this will be reported. (default: 10m)
+	MaxPendingDuration time.Duration
+}
+
+func (d *PendingPVC) maxPendingDuration() time.Duration {
+	if d.MaxPendingDuration == 0 {
+		return 10 * time.Minute
+	}
+	return d.MaxPendingDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *PendingPVC) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pending_pvc",
+			Description: fmt.Sprintf("Finding PersistentVolumeClaims stuck in a 'Pending' status longer than %s", d.maxPendingDuration()),
+			Labels:      []string{"kubernetes", "storage", "pvc"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PersistentVolumeClaimList: {Type: detek.TypeOf(v1.PersistentVolumeClaimList{})},
+			collector.KeyK8sStorageV1StorageClassList:       {Type: detek.TypeOf(storagev1.StorageClassList{})},
+			collector.KeyK8sCoreV1PodList:                   {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of PersistentVolumeClaims are not bound for a long time. Pods using them can not be started.",
+			Solution:    "Check the reason of each claim, and events of the claim (e.g, ProvisioningFailed). Check the StorageClass and the provisioner (CSI driver) is working properly.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *PendingPVC) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	pvcList, err := detek.Typing[v1.PersistentVolumeClaimList](
+		ctx.Get(collector.KeyK8sCoreV1PersistentVolumeClaimList, nil))
+	if err != nil {
+		return nil, err
+	}
+	scList, err := detek.Typing[storagev1.StorageClassList](
+		ctx.Get(collector.KeyK8sStorageV1StorageClassList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	classes := map[string]storagev1.StorageClass{}
+	hasDefault := false
+	for _, sc := range scList.Items {
+		classes[sc.Name] = sc
+		hasDefault = hasDefault || isDefaultStorageClass(sc)
+	}
+	usedBy := map[types.NamespacedName]string{}
+	for _, po := range podList.Items {
+		for _, claim := range claimsUsedBy(po) {
+			usedBy[types.NamespacedName{Namespace: po.Namespace, Name: claim}] = po.Name
+		}
+	}
+
+	type Problem struct {
+		Namespace    string
+		Name         string
+		StorageClass string
+		PendingFor   string
+		UsedBy       string `json:",omitempty"`
+		Reason       string
+	}
+	problems := []Problem{}
+
+	now := time.Now()
+	for _, pvc := range pvcList.Items {
+		if pvc.Status.Phase != v1.ClaimPending {
+			continue
+		}
+		pendingFor := now.Sub(pvc.CreationTimestamp.Time)
+		if pendingFor < d.maxPendingDuration() {
+			continue
+		}
+		p := Problem{
+			Namespace:  pvc.Namespace,
+			Name:       pvc.Name,
+			PendingFor: pendingFor.Truncate(time.Second).String(),
+			UsedBy:     usedBy[types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}],
+		}
+		className := storageClassNameOf(pvc)
+		sc, exists := storagev1.StorageClass{}, false
+		if className != nil {
+			p.StorageClass = *className
+			sc, exists = classes[*className]
+		}
+		switch {
+		case pvc.Spec.VolumeName != "":
+			p.Reason = fmt.Sprintf("waiting for the PersistentVolume %q to be bound", pvc.Spec.VolumeName)
+		case className == nil && !hasDefault:
+			p.Reason = "no StorageClass is requested, and there is no default StorageClass"
+		case className != nil && *className == "":
+			p.Reason = "waiting for a matching PersistentVolume to be created manually (dynamic provisioning is disabled)"
+		case className != nil && !exists:
+			p.Reason = "StorageClass does not exist"
+		case exists && sc.VolumeBindingMode != nil && *sc.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer:
+			if p.UsedBy == "" {
+				// will be provisioned when a pod uses it
+				continue
+			}
+			p.Reason = "waiting for the consumer pod to be scheduled"
+		default:
+			p.Reason = "waiting for the volume to be provisioned"
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Pending PersistentVolumeClaims",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated PersistentVolumeClaims", Data: len(pvcList.Items)},
+		},
+	}, nil
+}
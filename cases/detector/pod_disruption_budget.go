@@ -0,0 +1,43 @@
+package detector
+
+import (
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type pdbSelector struct {
+	policyv1.PodDisruptionBudget
+	selector labels.Selector
+}
+
+// pdbSelectorsOf parses selectors of PodDisruptionBudgets. PDBs with invalid selectors are skipped.
+// (in policy/v1, a null selector selects nothing and an empty selector selects every pod in the namespace)
+func pdbSelectorsOf(pdbList policyv1.PodDisruptionBudgetList) []pdbSelector {
+	result := []pdbSelector{}
+	for _, pdb := range pdbList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		result = append(result, pdbSelector{pdb, selector})
+	}
+	return result
+}
+
+// matches returns whether a PDB selects pods with given labels in a given namespace.
+func (p pdbSelector) matches(namespace string, podLabels map[string]string) bool {
+	return p.Namespace == namespace && p.selector.Matches(labels.Set(podLabels))
+}
+
+// pdbsOf returns names of PDBs selecting a given pod.
+func pdbsOf(pdbs []pdbSelector, po v1.Pod) []string {
+	result := []string{}
+	for _, pdb := range pdbs {
+		if pdb.matches(po.Namespace, po.Labels) {
+			result = append(result, pdb.Name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,230 @@
+package detector
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Pod Security Standards (https://kubernetes.io/docs/concepts/security/pod-security-standards/)
+type podSecurityLevel string
+
+const (
+	podSecurityPrivileged podSecurityLevel = "privileged"
+	podSecurityBaseline   podSecurityLevel = "baseline"
+	podSecurityRestricted podSecurityLevel = "restricted"
+)
+
+type podSecurityViolation struct {
+	// the profile which does not allow this
+	Profile   podSecurityLevel
+	Check     string
+	Container string `json:",omitempty"`
+	Detail    string
+}
+
+var (
+	// capabilities allowed to be added in the baseline profile
+	baselineCapabilities = map[v1.Capability]bool{
+		"AUDIT_WRITE": true, "CHOWN": true, "DAC_OVERRIDE": true, "FOWNER": true, "FSETID": true,
+		"KILL": true, "MKNOD": true, "NET_BIND_SERVICE": true, "SETFCAP": true, "SETGID": true,
+		"SETPCAP": true, "SETUID": true, "SYS_CHROOT": true,
+	}
+	// sysctls allowed in the baseline profile
+	baselineSysctls = map[string]bool{
+		"kernel.shm_rmid_forced": true, "net.ipv4.ip_local_port_range": true, "net.ipv4.ip_unprivileged_port_start": true,
+		"net.ipv4.tcp_syncookies": true, "net.ipv4.ping_group_range": true,
+	}
+	// SELinux types allowed in the baseline profile
+	baselineSELinuxTypes = map[string]bool{
+		"": true, "container_t": true, "container_init_t": true, "container_kvm_t": true,
+	}
+)
+
+type podContainer struct {
+	Name            string
+	SecurityContext *v1.SecurityContext
+	Ports           []v1.ContainerPort
+}
+
+func containersOf(po v1.Pod) []podContainer {
+	result := []podContainer{}
+	for _, co := range po.Spec.InitContainers {
+		result = append(result, podContainer{co.Name, co.SecurityContext, co.Ports})
+	}
+	for _, co := range po.Spec.Containers {
+		result = append(result, podContainer{co.Name, co.SecurityContext, co.Ports})
+	}
+	for _, co := range po.Spec.EphemeralContainers {
+		result = append(result, podContainer{co.Name, co.SecurityContext, co.Ports})
+	}
+	return result
+}
+
+// evaluatePodSecurity returns every violation of the baseline and restricted profiles.
+func evaluatePodSecurity(po v1.Pod) []podSecurityViolation {
+	violations := []podSecurityViolation{}
+	add := func(profile podSecurityLevel, check, container, format string, a ...any) {
+		violations = append(violations, podSecurityViolation{
+			Profile:   profile,
+			Check:     check,
+			Container: container,
+			Detail:    fmt.Sprintf(format, a...),
+		})
+	}
+	spec := po.Spec
+	psc := spec.SecurityContext
+	if psc == nil {
+		psc = &v1.PodSecurityContext{}
+	}
+	containers := containersOf(po)
+
+	// Baseline
+	if spec.HostNetwork {
+		add(podSecurityBaseline, "hostNetwork", "", "hostNetwork=true")
+	}
+	if spec.HostPID {
+		add(podSecurityBaseline, "hostPID", "", "hostPID=true")
+	}
+	if spec.HostIPC {
+		add(podSecurityBaseline, "hostIPC", "", "hostIPC=true")
+	}
+	if w := psc.WindowsOptions; w != nil && w.HostProcess != nil && *w.HostProcess {
+		add(podSecurityBaseline, "hostProcess", "", "windowsOptions.hostProcess=true")
+	}
+	for _, vol := range spec.Volumes {
+		if vol.HostPath != nil {
+			add(podSecurityBaseline, "hostPathVolumes", "", "volume %q uses hostPath %q", vol.Name, vol.HostPath.Path)
+		}
+	}
+	for _, sysctl := range psc.Sysctls {
+		if !baselineSysctls[sysctl.Name] {
+			add(podSecurityBaseline, "sysctls", "", "sysctl %q is not allowed", sysctl.Name)
+		}
+	}
+	if so := psc.SeccompProfile; so != nil && so.Type == v1.SeccompProfileTypeUnconfined {
+		add(podSecurityBaseline, "seccompProfile_baseline", "", "pod seccompProfile is Unconfined")
+	}
+	if se := psc.SELinuxOptions; se != nil {
+		checkSELinux(se, "", add)
+	}
+	for key, value := range po.Annotations {
+		if strings.HasPrefix(key, v1.AppArmorBetaContainerAnnotationKeyPrefix) &&
+			value != v1.AppArmorBetaProfileRuntimeDefault && !strings.HasPrefix(value, v1.AppArmorBetaProfileNamePrefix) {
+			add(podSecurityBaseline, "appArmorProfile", strings.TrimPrefix(key, v1.AppArmorBetaContainerAnnotationKeyPrefix),
+				"AppArmor profile %q is not allowed", value)
+		}
+	}
+	for _, co := range containers {
+		for _, port := range co.Ports {
+			if port.HostPort != 0 {
+				add(podSecurityBaseline, "hostPorts", co.Name, "hostPort %d", port.HostPort)
+			}
+		}
+		sc := co.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			add(podSecurityBaseline, "privileged", co.Name, "privileged=true")
+		}
+		if w := sc.WindowsOptions; w != nil && w.HostProcess != nil && *w.HostProcess {
+			add(podSecurityBaseline, "hostProcess", co.Name, "windowsOptions.hostProcess=true")
+		}
+		if sc.Capabilities != nil {
+			for _, c := range sc.Capabilities.Add {
+				if !baselineCapabilities[c] {
+					add(podSecurityBaseline, "capabilities_baseline", co.Name, "capability %q is added", c)
+				}
+			}
+		}
+		if sc.ProcMount != nil && *sc.ProcMount != v1.DefaultProcMount {
+			add(podSecurityBaseline, "procMount", co.Name, "procMount=%s", *sc.ProcMount)
+		}
+		if so := sc.SeccompProfile; so != nil && so.Type == v1.SeccompProfileTypeUnconfined {
+			add(podSecurityBaseline, "seccompProfile_baseline", co.Name, "seccompProfile is Unconfined")
+		}
+		if se := sc.SELinuxOptions; se != nil {
+			checkSELinux(se, co.Name, add)
+		}
+	}
+
+	// Restricted
+	for _, vol := range spec.Volumes {
+		src := vol.VolumeSource
+		if src.HostPath != nil {
+			// already reported by baseline
+			continue
+		}
+		if src.ConfigMap == nil && src.CSI == nil && src.DownwardAPI == nil && src.EmptyDir == nil &&
+			src.Ephemeral == nil && src.PersistentVolumeClaim == nil && src.Projected == nil && src.Secret == nil {
+			add(podSecurityRestricted, "restrictedVolumes", "", "volume %q uses a restricted volume type", vol.Name)
+		}
+	}
+	if psc.RunAsUser != nil && *psc.RunAsUser == 0 {
+		add(podSecurityRestricted, "runAsUser", "", "pod runAsUser=0")
+	}
+	podRunAsNonRoot := psc.RunAsNonRoot != nil && *psc.RunAsNonRoot
+	podSeccomp := psc.SeccompProfile != nil && psc.SeccompProfile.Type != v1.SeccompProfileTypeUnconfined
+	for _, co := range containers {
+		sc := co.SecurityContext
+		if sc == nil {
+			sc = &v1.SecurityContext{}
+		}
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			add(podSecurityRestricted, "allowPrivilegeEscalation", co.Name, "allowPrivilegeEscalation is not false")
+		}
+		if sc.RunAsNonRoot != nil && !*sc.RunAsNonRoot {
+			add(podSecurityRestricted, "runAsNonRoot", co.Name, "runAsNonRoot=false")
+		} else if sc.RunAsNonRoot == nil && !podRunAsNonRoot {
+			add(podSecurityRestricted, "runAsNonRoot", co.Name, "runAsNonRoot is not set")
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			add(podSecurityRestricted, "runAsUser", co.Name, "runAsUser=0")
+		}
+		if sc.SeccompProfile == nil && !podSeccomp {
+			add(podSecurityRestricted, "seccompProfile_restricted", co.Name, "seccompProfile is not set to RuntimeDefault or Localhost")
+		}
+		dropAll := false
+		if caps := sc.Capabilities; caps != nil {
+			for _, c := range caps.Drop {
+				if c == "ALL" {
+					dropAll = true
+				}
+			}
+			for _, c := range caps.Add {
+				if c != "NET_BIND_SERVICE" && baselineCapabilities[c] {
+					add(podSecurityRestricted, "capabilities_restricted", co.Name, "capability %q is added", c)
+				}
+			}
+		}
+		if !dropAll {
+			add(podSecurityRestricted, "capabilities_restricted", co.Name, "capabilities does not drop \"ALL\"")
+		}
+	}
+	return violations
+}
+
+func checkSELinux(se *v1.SELinuxOptions, container string, add func(podSecurityLevel, string, string, string, ...any)) {
+	if !baselineSELinuxTypes[se.Type] {
+		add(podSecurityBaseline, "seLinuxOptions", container, "SELinux type %q is not allowed", se.Type)
+	}
+	if se.User != "" || se.Role != "" {
+		add(podSecurityBaseline, "seLinuxOptions", container, "custom SELinux user or role is set")
+	}
+}
+
+// enforceableLevelOf returns the most restrictive profile, which allows every given violations.
+func enforceableLevelOf(violations []podSecurityViolation) podSecurityLevel {
+	level := podSecurityRestricted
+	for _, v := range violations {
+		switch v.Profile {
+		case podSecurityBaseline:
+			return podSecurityPrivileged
+		case podSecurityRestricted:
+			level = podSecurityBaseline
+		}
+	}
+	return level
+}
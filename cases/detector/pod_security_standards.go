@@ -0,0 +1,126 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &PodSecurityStandards{}
+
+type PodSecurityStandards struct {
+	// Namespaces not to be evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*PodSecurityStandards) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pod_security_standards_violation",
+			Description: "Evaluating every pod against the baseline and restricted Pod Security Standards, to find which level each namespace could enforce",
+			Labels:      []string{"kubernetes", "pod", "security"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList: {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods violate the Pod Security Standards. " +
+				"Enforcing a stricter level than the one shown for each namespace with Pod Security Admission will reject those pods.",
+			Solution: "Fix security contexts of the violating pods, then label namespaces with the level they can enforce " +
+				"(e.g, `kubectl label ns <name> pod-security.kubernetes.io/enforce=baseline`). " +
+				"For more information, please refer https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *PodSecurityStandards) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+
+	type Namespace struct {
+		Namespace            string
+		EnforceableLevel     podSecurityLevel
+		Pods                 int
+		BaselineViolations   int
+		RestrictedViolations int
+	}
+	type Violation struct {
+		Namespace string
+		Name      string
+		podSecurityViolation
+	}
+	namespaces := make(map[string]*Namespace)
+	violations := []Violation{}
+
+	for _, po := range podList.Items {
+		if excluded[po.Namespace] {
+			continue
+		}
+		ns, ok := namespaces[po.Namespace]
+		if !ok {
+			ns = &Namespace{Namespace: po.Namespace, EnforceableLevel: podSecurityRestricted}
+			namespaces[po.Namespace] = ns
+		}
+		ns.Pods++
+
+		podViolations := evaluatePodSecurity(po)
+		for _, v := range podViolations {
+			switch v.Profile {
+			case podSecurityBaseline:
+				ns.BaselineViolations++
+			case podSecurityRestricted:
+				ns.RestrictedViolations++
+			}
+			violations = append(violations, Violation{Namespace: po.Namespace, Name: po.Name, podSecurityViolation: v})
+		}
+		if level := enforceableLevelOf(podViolations); podSecurityRank(level) < podSecurityRank(ns.EnforceableLevel) {
+			ns.EnforceableLevel = level
+		}
+	}
+
+	coverage := []Namespace{}
+	for _, ns := range namespaces {
+		coverage = append(coverage, *ns)
+	}
+	sort.Slice(coverage, func(i, j int) bool {
+		if a, b := podSecurityRank(coverage[i].EnforceableLevel), podSecurityRank(coverage[j].EnforceableLevel); a != b {
+			return a < b
+		}
+		return coverage[i].Namespace < coverage[j].Namespace
+	})
+
+	return &detek.ReportSpec{
+		HasPassed: len(violations) == 0,
+		Problem: detek.JSONableData{
+			Description: "The most restrictive Pod Security Standards level each namespace could enforce today",
+			Data:        coverage,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "Violations", Data: violations},
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
+
+func podSecurityRank(level podSecurityLevel) int {
+	switch level {
+	case podSecurityRestricted:
+		return 2
+	case podSecurityBaseline:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,92 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &PodWithMissingPVC{}
+
+type PodWithMissingPVC struct{}
+
+// GetMeta implements detek.Detector
+func (*PodWithMissingPVC) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "pod_with_missing_pvc",
+			Description: "Finding pods referencing PersistentVolumeClaims which do not exist",
+			Labels:      []string{"kubernetes", "storage", "pod", "pvc"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:                   {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sCoreV1PersistentVolumeClaimList: {Type: detek.TypeOf(v1.PersistentVolumeClaimList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of pods reference PersistentVolumeClaims which do not exist. Those pods can not be started.",
+			Solution:    "Create the PersistentVolumeClaims, or fix the claimName of volumes in the pod template.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*PodWithMissingPVC) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pvcList, err := detek.Typing[v1.PersistentVolumeClaimList](
+		ctx.Get(collector.KeyK8sCoreV1PersistentVolumeClaimList, nil))
+	if err != nil {
+		return nil, err
+	}
+	claims := map[types.NamespacedName]bool{}
+	for _, pvc := range pvcList.Items {
+		claims[types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}] = true
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Workload  string
+		Volume    string
+		ClaimName string
+	}
+	problems := []Problem{}
+
+	for _, po := range podList.Items {
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		for _, vol := range po.Spec.Volumes {
+			// generic ephemeral volumes are created with the pod
+			if vol.PersistentVolumeClaim == nil {
+				continue
+			}
+			claim := vol.PersistentVolumeClaim.ClaimName
+			if !claims[types.NamespacedName{Namespace: po.Namespace, Name: claim}] {
+				problems = append(problems, Problem{
+					Namespace: po.Namespace,
+					Name:      po.Name,
+					Workload:  workloadOf(po),
+					Volume:    vol.Name,
+					ClaimName: claim,
+				})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Pods referencing missing PersistentVolumeClaims",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Pods", Data: len(podList.Items)},
+		},
+	}, nil
+}
@@ -22,8 +22,8 @@ func (*PodWithoutReadinessProbe) GetMeta() detek.DetectorInfo {
 			Labels:      []string{"kubernetes", "pod", "probe"},
 		},
 		Required: detek.DependencyMeta{
-			collector.KeyK8sCoreV1PodList:      {Type: detek.TypeOf(v1.PodList{})},
-			collector.KeyK8sCoreV1EndpointList: {Type: detek.TypeOf(v1.EndpointsList{})},
+			collector.KeyK8sCoreV1PodList:    {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sServiceEndpoints: {Type: detek.TypeOf([]collector.ServiceEndpoints{})},
 		},
 		Level: detek.Warn,
 		IfHappened: detek.Description{
@@ -35,8 +35,8 @@ func (*PodWithoutReadinessProbe) GetMeta() detek.DetectorInfo {
 
 // Do implements detek.Detector
 func (*PodWithoutReadinessProbe) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
-	epList, err := detek.Typing[v1.EndpointsList](
-		ctx.Get(collector.KeyK8sCoreV1EndpointList, nil))
+	serviceEndpoints, err := detek.Typing[[]collector.ServiceEndpoints](
+		ctx.Get(collector.KeyK8sServiceEndpoints, nil))
 	if err != nil {
 		return nil, err
 	}
@@ -46,15 +46,13 @@ func (*PodWithoutReadinessProbe) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 		return nil, err
 	}
 
-	podFilter := make(map[types.UID]v1.Endpoints)
-	for _, ep := range epList.Items {
-		for _, sub := range ep.Subsets {
-			for _, addr := range append(sub.Addresses, sub.NotReadyAddresses...) {
-				if addr.TargetRef == nil {
-					continue
-				}
-				podFilter[addr.TargetRef.UID] = ep
+	podFilter := make(map[types.UID]collector.ServiceEndpoints)
+	for _, se := range serviceEndpoints {
+		for _, ep := range se.Endpoints {
+			if ep.TargetRef == nil {
+				continue
 			}
+			podFilter[ep.TargetRef.UID] = se
 		}
 	}
 
@@ -78,7 +76,7 @@ func (*PodWithoutReadinessProbe) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 	for _, po := range targetPods {
 		for _, co := range po.Spec.Containers {
 			if co.ReadinessProbe == nil {
-				ep := podFilter[po.UID]
+				se := podFilter[po.UID]
 				OwnerString := ""
 				for _, o := range po.OwnerReferences {
 					OwnerString += fmt.Sprintf("%s/%s", o.Kind, o.Name)
@@ -88,7 +86,7 @@ func (*PodWithoutReadinessProbe) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 					Name:         po.Name,
 					Container:    co.Name,
 					Owner:        OwnerString,
-					ReferencedBy: fmt.Sprintf("Service/%s", ep.Name),
+					ReferencedBy: fmt.Sprintf("Service/%s", se.Name),
 				})
 			}
 		}
@@ -102,7 +100,7 @@ func (*PodWithoutReadinessProbe) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 		},
 		Attachment: []detek.JSONableData{
 			{Description: "# of evaluated Pod", Data: len(targetPods)},
-			{Description: "# of evaluated Endpoints", Data: len(podFilter)},
+			{Description: "# of evaluated Services", Data: len(serviceEndpoints)},
 		},
 	}, nil
 }
@@ -0,0 +1,155 @@
+package detector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// rbacRequired is dependencies to build rbacIndex.
+func rbacRequired() detek.DependencyMeta {
+	return detek.DependencyMeta{
+		collector.KeyK8sRbacV1RoleList:               {Type: detek.TypeOf(rbacv1.RoleList{})},
+		collector.KeyK8sRbacV1ClusterRoleList:        {Type: detek.TypeOf(rbacv1.ClusterRoleList{})},
+		collector.KeyK8sRbacV1RoleBindingList:        {Type: detek.TypeOf(rbacv1.RoleBindingList{})},
+		collector.KeyK8sRbacV1ClusterRoleBindingList: {Type: detek.TypeOf(rbacv1.ClusterRoleBindingList{})},
+	}
+}
+
+// rbacBinding is either a RoleBinding or a ClusterRoleBinding.
+type rbacBinding struct {
+	Kind      string
+	Namespace string
+	Name      string
+	RoleRef   rbacv1.RoleRef
+	Subjects  []rbacv1.Subject
+}
+
+// String returns a text representation of the binding. (e.g, "RoleBinding/default/view")
+func (b rbacBinding) String() string {
+	if b.Namespace == "" {
+		return b.Kind + "/" + b.Name
+	}
+	return b.Kind + "/" + b.Namespace + "/" + b.Name
+}
+
+type rbacIndex struct {
+	roles        map[string]rbacv1.Role
+	clusterRoles map[string]rbacv1.ClusterRole
+	bindings     []rbacBinding
+}
+
+func loadRBACIndex(ctx detek.DetekContext) (*rbacIndex, error) {
+	roleList, err := detek.Typing[rbacv1.RoleList](
+		ctx.Get(collector.KeyK8sRbacV1RoleList, nil))
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleList, err := detek.Typing[rbacv1.ClusterRoleList](
+		ctx.Get(collector.KeyK8sRbacV1ClusterRoleList, nil))
+	if err != nil {
+		return nil, err
+	}
+	roleBindingList, err := detek.Typing[rbacv1.RoleBindingList](
+		ctx.Get(collector.KeyK8sRbacV1RoleBindingList, nil))
+	if err != nil {
+		return nil, err
+	}
+	clusterRoleBindingList, err := detek.Typing[rbacv1.ClusterRoleBindingList](
+		ctx.Get(collector.KeyK8sRbacV1ClusterRoleBindingList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	index := &rbacIndex{
+		roles:        map[string]rbacv1.Role{},
+		clusterRoles: map[string]rbacv1.ClusterRole{},
+		bindings:     []rbacBinding{},
+	}
+	for _, r := range roleList.Items {
+		index.roles[r.Namespace+"/"+r.Name] = r
+	}
+	for _, r := range clusterRoleList.Items {
+		index.clusterRoles[r.Name] = r
+	}
+	for _, b := range clusterRoleBindingList.Items {
+		index.bindings = append(index.bindings, rbacBinding{"ClusterRoleBinding", "", b.Name, b.RoleRef, b.Subjects})
+	}
+	for _, b := range roleBindingList.Items {
+		index.bindings = append(index.bindings, rbacBinding{"RoleBinding", b.Namespace, b.Name, b.RoleRef, b.Subjects})
+	}
+	return index, nil
+}
+
+// rulesOf returns rules of the role referenced by a given binding. (false if the role does not exist)
+func (index *rbacIndex) rulesOf(b rbacBinding) ([]rbacv1.PolicyRule, bool) {
+	switch b.RoleRef.Kind {
+	case "ClusterRole":
+		r, ok := index.clusterRoles[b.RoleRef.Name]
+		return r.Rules, ok
+	case "Role":
+		r, ok := index.roles[b.Namespace+"/"+b.RoleRef.Name]
+		return r.Rules, ok
+	}
+	return nil, false
+}
+
+// ruleAllows returns whether a rule allows a verb on any object of a resource. (e.g, "pods/exec")
+// rules scoped with resourceNames are not considered to allow it.
+func ruleAllows(rule rbacv1.PolicyRule, verb, group, resource string) bool {
+	return len(rule.ResourceNames) == 0 &&
+		hasRBACValue(rule.Verbs, verb) &&
+		hasRBACValue(rule.APIGroups, group) &&
+		hasRBACValue(rule.Resources, resource)
+}
+
+// hasRBACValue returns whether values contain a given value, or a wildcard.
+// (subresources like "pods/exec" are also matched by "*/exec")
+func hasRBACValue(values []string, value string) bool {
+	_, subresource, isSubresource := strings.Cut(value, "/")
+	for _, v := range values {
+		if v == rbacv1.VerbAll || v == value {
+			return true
+		}
+		if isSubresource && v == "*/"+subresource {
+			return true
+		}
+	}
+	return false
+}
+
+func isSystemRBACName(name string) bool {
+	return strings.HasPrefix(name, "system:")
+}
+
+// isSystemComponentSubject returns whether a subject is a control plane component or a node, which are powerful by design.
+// groups like "system:authenticated" or "system:serviceaccounts" are not, since they include ordinary users.
+func isSystemComponentSubject(s rbacv1.Subject) bool {
+	switch s.Kind {
+	case rbacv1.GroupKind:
+		return s.Name == "system:masters"
+	case rbacv1.UserKind:
+		return strings.HasPrefix(s.Name, "system:kube-") || strings.HasPrefix(s.Name, "system:node:")
+	}
+	return false
+}
+
+// subjectString returns a text representation of a subject. (e.g, "ServiceAccount/default/default")
+func subjectString(b rbacBinding, s rbacv1.Subject) string {
+	if s.Kind == rbacv1.ServiceAccountKind {
+		return fmt.Sprintf("%s/%s/%s", s.Kind, subjectNamespaceOf(b, s), s.Name)
+	}
+	return fmt.Sprintf("%s/%s", s.Kind, s.Name)
+}
+
+// subjectNamespaceOf returns the namespace of a ServiceAccount subject.
+// (subjects of RoleBindings may omit their namespace)
+func subjectNamespaceOf(b rbacBinding, s rbacv1.Subject) string {
+	if s.Namespace == "" {
+		return b.Namespace
+	}
+	return s.Namespace
+}
@@ -0,0 +1,84 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ detek.Detector = &RBACBindingMissingSubject{}
+
+type RBACBindingMissingSubject struct{}
+
+// GetMeta implements detek.Detector
+func (*RBACBindingMissingSubject) GetMeta() detek.DetectorInfo {
+	required := rbacRequired()
+	required[collector.KeyK8sCoreV1ServiceAccountList] = detek.DependencyInfo{Type: detek.TypeOf(v1.ServiceAccountList{})}
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "rbac_binding_missing_subject",
+			Description: "Finding bindings to ServiceAccounts (or roles) which do not exist",
+			Labels:      []string{"kubernetes", "rbac", "security"},
+		},
+		Required: required,
+		Level:    detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of bindings reference ServiceAccounts or roles which do not exist. Anyone who creates a ServiceAccount (or a role) with the same name will get (or grant) those permissions.",
+			Solution:    "Delete bindings which are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*RBACBindingMissingSubject) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	index, err := loadRBACIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	saList, err := detek.Typing[v1.ServiceAccountList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceAccountList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serviceAccounts := map[string]bool{}
+	for _, sa := range saList.Items {
+		serviceAccounts[sa.Namespace+"/"+sa.Name] = true
+	}
+
+	type Problem struct {
+		Binding string
+		Missing string
+	}
+	problems := []Problem{}
+
+	for _, b := range index.bindings {
+		if _, ok := index.rulesOf(b); !ok {
+			missing := b.RoleRef.Kind + "/" + b.RoleRef.Name
+			if b.RoleRef.Kind == "Role" {
+				missing = b.RoleRef.Kind + "/" + b.Namespace + "/" + b.RoleRef.Name
+			}
+			problems = append(problems, Problem{Binding: b.String(), Missing: missing})
+		}
+		for _, s := range b.Subjects {
+			if s.Kind != rbacv1.ServiceAccountKind {
+				// users and groups can not be verified
+				continue
+			}
+			if !serviceAccounts[subjectNamespaceOf(b, s)+"/"+s.Name] {
+				problems = append(problems, Problem{Binding: b.String(), Missing: subjectString(b, s)})
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Bindings referencing missing subjects (or roles)",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated bindings", Data: len(index.bindings)},
+		},
+	}, nil
+}
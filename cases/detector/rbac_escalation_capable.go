@@ -0,0 +1,135 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/pkg/detek"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// escalationCapablePermission is a permission which can be used to gain more permissions.
+type escalationCapablePermission struct {
+	Name string
+	// granted only by ClusterRoleBindings (e.g, reading secrets across namespaces)
+	ClusterWideOnly bool
+	allows          func(rule rbacv1.PolicyRule) bool
+}
+
+var escalationCapablePermissions = []escalationCapablePermission{
+	{Name: "bind roles", allows: func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, "bind", rbacv1.GroupName, "roles") || ruleAllows(rule, "bind", rbacv1.GroupName, "clusterroles")
+	}},
+	{Name: "escalate roles", allows: func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, "escalate", rbacv1.GroupName, "roles") || ruleAllows(rule, "escalate", rbacv1.GroupName, "clusterroles")
+	}},
+	{Name: "impersonate", allows: func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, "impersonate", "", "users") || ruleAllows(rule, "impersonate", "", "groups") ||
+			ruleAllows(rule, "impersonate", "", "serviceaccounts")
+	}},
+	{Name: "create pods/exec", allows: func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, "create", "", "pods/exec")
+	}},
+	{Name: "read secrets in every namespace", ClusterWideOnly: true, allows: func(rule rbacv1.PolicyRule) bool {
+		return ruleAllows(rule, "get", "", "secrets") || ruleAllows(rule, "list", "", "secrets") ||
+			ruleAllows(rule, "watch", "", "secrets")
+	}},
+}
+
+var _ detek.Detector = &RBACEscalationCapable{}
+
+type RBACEscalationCapable struct {
+	// ServiceAccounts in these namespaces are not evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*RBACEscalationCapable) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "rbac_escalation_capable",
+			Description: "Finding subjects with permissions which can be used for privilege escalation (bind, escalate, impersonate, pods/exec, secrets across namespaces)",
+			Labels:      []string{"kubernetes", "rbac", "security"},
+		},
+		Required: rbacRequired(),
+		Level:    detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of subjects have permissions which let them gain more permissions than they are granted (e.g, binding any role, impersonating others, or reading tokens in secrets).",
+			Solution: "Remove those permissions if not needed, or limit them with resourceNames. " +
+				"For more information, please refer https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *RBACEscalationCapable) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	index, err := loadRBACIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+
+	type Problem struct {
+		Subject     string
+		Binding     string
+		Role        string
+		Permissions []string
+	}
+	problems := []Problem{}
+
+	for _, b := range index.bindings {
+		// built-in roles, and cluster-admin (reported by service_account_cluster_admin) are not evaluated
+		if isSystemRBACName(b.RoleRef.Name) || b.RoleRef.Name == "cluster-admin" {
+			continue
+		}
+		rules, ok := index.rulesOf(b)
+		if !ok {
+			continue
+		}
+		permissions := []string{}
+		for _, perm := range escalationCapablePermissions {
+			if perm.ClusterWideOnly && b.Kind != "ClusterRoleBinding" {
+				continue
+			}
+			for _, rule := range rules {
+				if perm.allows(rule) {
+					permissions = append(permissions, perm.Name)
+					break
+				}
+			}
+		}
+		if len(permissions) == 0 {
+			continue
+		}
+		for _, s := range b.Subjects {
+			if isSystemComponentSubject(s) {
+				continue
+			}
+			if s.Kind == rbacv1.ServiceAccountKind && excluded[subjectNamespaceOf(b, s)] {
+				continue
+			}
+			problems = append(problems, Problem{
+				Subject:     subjectString(b, s),
+				Binding:     b.String(),
+				Role:        b.RoleRef.Kind + "/" + b.RoleRef.Name,
+				Permissions: permissions,
+			})
+		}
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Subject < problems[j].Subject
+	})
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Subjects with escalation-capable permissions",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated bindings", Data: len(index.bindings)},
+		},
+	}, nil
+}
@@ -0,0 +1,193 @@
+package detector
+
+import (
+	"reflect"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestHasRBACValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		value  string
+		want   bool
+	}{
+		{name: "exact", values: []string{"get", "list"}, value: "list", want: true},
+		{name: "not contained", values: []string{"get", "list"}, value: "create", want: false},
+		{name: "wildcard", values: []string{"*"}, value: "create", want: true},
+		{name: "empty group", values: []string{""}, value: "", want: true},
+		{name: "empty values", values: nil, value: "get", want: false},
+		{name: "wildcard matches subresource", values: []string{"*"}, value: "pods/exec", want: true},
+		{name: "wildcard resource of subresource", values: []string{"*/exec"}, value: "pods/exec", want: true},
+		{name: "other subresource", values: []string{"*/log"}, value: "pods/exec", want: false},
+		{name: "resource does not match subresource", values: []string{"pods"}, value: "pods/exec", want: false},
+		{name: "subresource wildcard does not match resource", values: []string{"*/exec"}, value: "pods", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasRBACValue(tt.values, tt.value); got != tt.want {
+				t.Errorf("hasRBACValue(%v, %q) = %v, want %v", tt.values, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleAllows(t *testing.T) {
+	type args struct {
+		verb     string
+		group    string
+		resource string
+	}
+	tests := []struct {
+		name string
+		rule rbacv1.PolicyRule
+		args args
+		want bool
+	}{
+		{
+			name: "allowed",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			args: args{"get", "", "secrets"},
+			want: true,
+		},
+		{
+			name: "all wildcards",
+			rule: rbacv1.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			args: args{"create", "", "pods/exec"},
+			want: true,
+		},
+		{
+			name: "other group",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{"apps"}, Resources: []string{"secrets"}},
+			args: args{"get", "", "secrets"},
+			want: false,
+		},
+		{
+			name: "other verb",
+			rule: rbacv1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			args: args{"get", "", "secrets"},
+			want: false,
+		},
+		{
+			name: "scoped with resourceNames",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"my-secret"}},
+			args: args{"get", "", "secrets"},
+			want: false,
+		},
+		{
+			name: "wildcard subresource",
+			rule: rbacv1.PolicyRule{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"*/exec"}},
+			args: args{"create", "", "pods/exec"},
+			want: true,
+		},
+		{
+			name: "non resource urls",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get"}, NonResourceURLs: []string{"*"}},
+			args: args{"get", "", "secrets"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleAllows(tt.rule, tt.args.verb, tt.args.group, tt.args.resource); got != tt.want {
+				t.Errorf("ruleAllows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscalationCapablePermissions(t *testing.T) {
+	tests := []struct {
+		name string
+		rule rbacv1.PolicyRule
+		// names of allowed permissions, and whether each of them is granted only by ClusterRoleBindings
+		want map[string]bool
+	}{
+		{
+			name: "read only",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get", "list"}, APIGroups: []string{""}, Resources: []string{"pods", "configmaps"}},
+			want: map[string]bool{},
+		},
+		{
+			name: "everything",
+			rule: rbacv1.PolicyRule{Verbs: []string{"*"}, APIGroups: []string{"*"}, Resources: []string{"*"}},
+			want: map[string]bool{
+				"bind roles":                      false,
+				"escalate roles":                  false,
+				"impersonate":                     false,
+				"create pods/exec":                false,
+				"read secrets in every namespace": true,
+			},
+		},
+		{
+			name: "bind clusterroles",
+			rule: rbacv1.PolicyRule{Verbs: []string{"bind"}, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"clusterroles"}},
+			want: map[string]bool{"bind roles": false},
+		},
+		{
+			name: "bind a named role",
+			rule: rbacv1.PolicyRule{Verbs: []string{"bind"}, APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles"}, ResourceNames: []string{"view"}},
+			want: map[string]bool{},
+		},
+		{
+			name: "impersonate serviceaccounts",
+			rule: rbacv1.PolicyRule{Verbs: []string{"impersonate"}, APIGroups: []string{""}, Resources: []string{"serviceaccounts"}},
+			want: map[string]bool{"impersonate": false},
+		},
+		{
+			name: "exec on any subresource wildcard",
+			rule: rbacv1.PolicyRule{Verbs: []string{"create"}, APIGroups: []string{""}, Resources: []string{"*/exec"}},
+			want: map[string]bool{"create pods/exec": false},
+		},
+		{
+			name: "get pods/exec only",
+			rule: rbacv1.PolicyRule{Verbs: []string{"get"}, APIGroups: []string{""}, Resources: []string{"pods/exec"}},
+			want: map[string]bool{},
+		},
+		{
+			name: "list secrets",
+			rule: rbacv1.PolicyRule{Verbs: []string{"list"}, APIGroups: []string{""}, Resources: []string{"secrets"}},
+			want: map[string]bool{"read secrets in every namespace": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]bool{}
+			for _, perm := range escalationCapablePermissions {
+				if perm.allows(tt.rule) {
+					got[perm.Name] = perm.ClusterWideOnly
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("escalationCapablePermissions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSystemComponentSubject(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject rbacv1.Subject
+		want    bool
+	}{
+		{name: "system:masters", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:masters"}, want: true},
+		{name: "kube-scheduler", subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "system:kube-scheduler"}, want: true},
+		{name: "node", subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "system:node:worker-1"}, want: true},
+		{name: "authenticated users", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:authenticated"}, want: false},
+		{name: "unauthenticated users", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:unauthenticated"}, want: false},
+		{name: "anonymous", subject: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "system:anonymous"}, want: false},
+		{name: "all serviceaccounts", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts"}, want: false},
+		{name: "serviceaccounts in a namespace", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:serviceaccounts:default"}, want: false},
+		{name: "group named like a node", subject: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "system:node:worker-1"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSystemComponentSubject(tt.subject); got != tt.want {
+				t.Errorf("isSystemComponentSubject(%v) = %v, want %v", tt.subject, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kakao/detek/pkg/detek"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ detek.Detector = &RBACWildcardRule{}
+
+type RBACWildcardRule struct{}
+
+// GetMeta implements detek.Detector
+func (*RBACWildcardRule) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "rbac_wildcard_rule",
+			Description: "Finding Roles and ClusterRoles with wildcard (\"*\") verbs or resources",
+			Labels:      []string{"kubernetes", "rbac", "security"},
+		},
+		Required: rbacRequired(),
+		Level:    detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of Roles grant wildcard permissions. They also grant permissions on resources (and verbs) added in the future, which are not intended.",
+			Solution:    "List verbs and resources explicitly in those Roles.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*RBACWildcardRule) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	index, err := loadRBACIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	boundBy := map[string][]string{}
+	for _, b := range index.bindings {
+		key := b.RoleRef.Kind + "/" + b.RoleRef.Name
+		if b.RoleRef.Kind == "Role" {
+			key = b.RoleRef.Kind + "/" + b.Namespace + "/" + b.RoleRef.Name
+		}
+		boundBy[key] = append(boundBy[key], b.String())
+	}
+
+	type Problem struct {
+		Role    string
+		Rules   []string
+		BoundBy []string
+	}
+	problems := []Problem{}
+	addProblem := func(role string, rules []rbacv1.PolicyRule) {
+		wildcards := []string{}
+		for _, rule := range rules {
+			if hasWildcard(rule.Verbs) || hasWildcard(rule.Resources) {
+				wildcards = append(wildcards, fmt.Sprintf("verbs=[%s] apiGroups=[%s] resources=[%s]",
+					strings.Join(rule.Verbs, ","), strings.Join(rule.APIGroups, ","), strings.Join(rule.Resources, ",")))
+			}
+		}
+		if len(wildcards) != 0 {
+			problems = append(problems, Problem{Role: role, Rules: wildcards, BoundBy: boundBy[role]})
+		}
+	}
+
+	for _, r := range index.clusterRoles {
+		// built-in roles are expected to have wildcards
+		if isSystemRBACName(r.Name) || r.Name == "cluster-admin" {
+			continue
+		}
+		addProblem("ClusterRole/"+r.Name, r.Rules)
+	}
+	for _, r := range index.roles {
+		if isSystemRBACName(r.Name) {
+			continue
+		}
+		addProblem("Role/"+r.Namespace+"/"+r.Name, r.Rules)
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Role < problems[j].Role
+	})
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Roles with wildcard rules",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Roles", Data: len(index.roles)},
+			{Description: "# of evaluated ClusterRoles", Data: len(index.clusterRoles)},
+		},
+	}, nil
+}
+
+func hasWildcard(values []string) bool {
+	for _, v := range values {
+		if v == rbacv1.VerbAll {
+			return true
+		}
+	}
+	return false
+}
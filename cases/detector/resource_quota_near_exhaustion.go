@@ -0,0 +1,124 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &ResourceQuotaNearExhaustion{}
+
+type ResourceQuotaNearExhaustion struct {
+	// quotas used more than this ratio will be reported. (default: 0.9)
+	UsageRatio float64
+}
+
+func (d *ResourceQuotaNearExhaustion) usageRatio() float64 {
+	if d.UsageRatio == 0 {
+		return 0.9
+	}
+	return d.UsageRatio
+}
+
+// GetMeta implements detek.Detector
+func (d *ResourceQuotaNearExhaustion) GetMeta() detek.DetectorInfo {
+	solution := "Increase the quota, or reduce resources used in the namespace (e.g, delete unused objects, lower requests)."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "resource_quota_near_exhaustion",
+			Description: fmt.Sprintf("Finding ResourceQuotas used more than %.0f%%", d.usageRatio()*100),
+			Labels:      []string{"kubernetes", "namespace", "resourcequota"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1ResourceQuotaList: {Type: detek.TypeOf(v1.ResourceQuotaList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of ResourceQuotas are exhausted. Creating (or scaling out) objects in those namespaces is rejected.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of ResourceQuotas are used more than %.0f%%. Creating (or scaling out) objects will be rejected soon.", d.usageRatio()*100),
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *ResourceQuotaNearExhaustion) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	quotaList, err := detek.Typing[v1.ResourceQuotaList](
+		ctx.Get(collector.KeyK8sCoreV1ResourceQuotaList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Resource  v1.ResourceName
+		Used      string
+		Hard      string
+		Usage     string
+		Level     detek.SeverityLevel
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+
+	for _, q := range quotaList.Items {
+		resources := []string{}
+		for name := range q.Status.Hard {
+			resources = append(resources, string(name))
+		}
+		sort.Strings(resources)
+		for _, name := range resources {
+			hard := q.Status.Hard[v1.ResourceName(name)]
+			used, ok := q.Status.Used[v1.ResourceName(name)]
+			if !ok {
+				continue
+			}
+			ratio := 1.0
+			if !hard.IsZero() {
+				ratio = used.AsApproximateFloat64() / hard.AsApproximateFloat64()
+			} else if used.IsZero() {
+				// nothing is allowed, and nothing is used (e.g, to disable a resource type)
+				continue
+			}
+			if ratio < d.usageRatio() {
+				continue
+			}
+			level := detek.Warn
+			if ratio >= 1 {
+				level = detek.Error
+			}
+			if level.ToInt() > observed.ToInt() {
+				observed = level
+			}
+			problems = append(problems, Problem{
+				Namespace: q.Namespace,
+				Name:      q.Name,
+				Resource:  v1.ResourceName(name),
+				Used:      used.String(),
+				Hard:      hard.String(),
+				Usage:     fmt.Sprintf("%.0f%%", ratio*100),
+				Level:     level,
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "ResourceQuotas near exhaustion",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated ResourceQuotas", Data: len(quotaList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,81 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// hasSelector returns whether endpoints of a given service are managed by its selector.
+// (Services without selectors, or ExternalName Services are managed manually)
+func hasSelector(svc v1.Service) bool {
+	return len(svc.Spec.Selector) != 0 && svc.Spec.Type != v1.ServiceTypeExternalName
+}
+
+// podsBySelector indexes pods by namespaces, to find pods selected by Services.
+type podsBySelector map[string][]v1.Pod
+
+func newPodsBySelector(podList v1.PodList) podsBySelector {
+	index := podsBySelector{}
+	for _, po := range podList.Items {
+		// finished pods will never be endpoints
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		index[po.Namespace] = append(index[po.Namespace], po)
+	}
+	return index
+}
+
+// selectedBy returns pods selected by a given Service.
+func (index podsBySelector) selectedBy(svc v1.Service) []v1.Pod {
+	result := []v1.Pod{}
+	if !hasSelector(svc) {
+		return result
+	}
+	selector := labels.SelectorFromSet(svc.Spec.Selector)
+	for _, po := range index[svc.Namespace] {
+		if selector.Matches(labels.Set(po.Labels)) {
+			result = append(result, po)
+		}
+	}
+	return result
+}
+
+// servicesSelecting returns names of Services selecting pods with given labels. (e.g, labels of a pod template)
+func servicesSelecting(serviceList v1.ServiceList, namespace string, podLabels map[string]string) []string {
+	result := []string{}
+	for _, svc := range serviceList.Items {
+		if svc.Namespace != namespace || !hasSelector(svc) {
+			continue
+		}
+		if labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(podLabels)) {
+			result = append(result, svc.Name)
+		}
+	}
+	return result
+}
+
+// endpointStates is endpoints of a Service, grouped by whether they can receive traffic. (e.g, "10.0.0.1 (Pod/default/nginx)")
+type endpointStates struct {
+	// ready, or terminating but still serving (e.g, during graceful termination of a rolling update)
+	Serving []string
+	// terminating, and not serving anymore
+	Terminating []string
+	NotReady    []string
+}
+
+func endpointStatesOf(se collector.ServiceEndpoints) endpointStates {
+	states := endpointStates{Serving: []string{}, Terminating: []string{}, NotReady: []string{}}
+	for _, ep := range se.Endpoints {
+		switch {
+		case ep.Ready || ep.Serving:
+			states.Serving = append(states.Serving, ep.String())
+		case ep.Terminating:
+			states.Terminating = append(states.Terminating, ep.String())
+		default:
+			states.NotReady = append(states.NotReady, ep.String())
+		}
+	}
+	return states
+}
@@ -0,0 +1,93 @@
+package detector
+
+import (
+	"github.com/kakao/detek/pkg/detek"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ detek.Detector = &ServiceAccountClusterAdmin{}
+
+type ServiceAccountClusterAdmin struct {
+	// ServiceAccounts in these namespaces are not evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*ServiceAccountClusterAdmin) GetMeta() detek.DetectorInfo {
+	solution := "Grant only permissions those workloads really need, with dedicated (Cluster)Roles."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "service_account_cluster_admin",
+			Description: "Finding service accounts bound to the cluster-admin ClusterRole",
+			Labels:      []string{"kubernetes", "rbac", "security"},
+		},
+		Required: rbacRequired(),
+		Level:    detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of ServiceAccounts have the cluster-admin role. Anyone who can run pods with (or read tokens of) those ServiceAccounts can take over the whole cluster.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: "Some of ServiceAccounts have the cluster-admin role within their namespaces (with RoleBindings). Anyone who can run pods with (or read tokens of) those ServiceAccounts can take over those namespaces.",
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *ServiceAccountClusterAdmin) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	index, err := loadRBACIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+
+	type Problem struct {
+		ServiceAccount string
+		Binding        string
+		Level          detek.SeverityLevel
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+
+	for _, b := range index.bindings {
+		if b.RoleRef.Kind != "ClusterRole" || b.RoleRef.Name != "cluster-admin" {
+			continue
+		}
+		// RoleBindings grant cluster-admin only within their namespaces
+		level := detek.Error
+		if b.Kind != "ClusterRoleBinding" {
+			level = detek.Warn
+		}
+		for _, s := range b.Subjects {
+			if s.Kind != rbacv1.ServiceAccountKind || excluded[subjectNamespaceOf(b, s)] {
+				continue
+			}
+			problems = append(problems, Problem{
+				ServiceAccount: subjectString(b, s),
+				Binding:        b.String(),
+				Level:          level,
+			})
+			if level.ToInt() > observed.ToInt() {
+				observed = level
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "ServiceAccounts bound to cluster-admin",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated bindings", Data: len(index.bindings)},
+		},
+	}, nil
+}
@@ -2,17 +2,47 @@ package detector
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/kakao/detek/cases/collector"
 	"github.com/kakao/detek/pkg/detek"
-	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ detek.Detector = &ServiceNoAvailableTarget{}
 
-type ServiceNoAvailableTarget struct{}
+type ServiceNoAvailableTarget struct {
+	// Services in these namespaces are reported as Fatal. (default: kube-system)
+	CriticalNamespaces []string
+	// Services in namespaces matching these patterns (e.g, "dev-*") are reported as Warn.
+	// Services in the other namespaces are reported as Error.
+	DevNamespacePatterns []string
+}
+
+func (d *ServiceNoAvailableTarget) criticalNamespaces() []string {
+	if d.CriticalNamespaces == nil {
+		return []string{metav1.NamespaceSystem}
+	}
+	return d.CriticalNamespaces
+}
 
-func (*ServiceNoAvailableTarget) GetMeta() detek.DetectorInfo {
+// levelOf returns the severity of an unavailable service in a given namespace.
+func (d *ServiceNoAvailableTarget) levelOf(namespace string) detek.SeverityLevel {
+	for _, ns := range d.criticalNamespaces() {
+		if ns == namespace {
+			return detek.Fatal
+		}
+	}
+	for _, pattern := range d.DevNamespacePatterns {
+		if ok, _ := path.Match(pattern, namespace); ok {
+			return detek.Warn
+		}
+	}
+	return detek.Error
+}
+
+func (d *ServiceNoAvailableTarget) GetMeta() detek.DetectorInfo {
+	solution := "Check if Service Selector is properly being set. Or check if all your Pods are working right now."
 	return detek.DetectorInfo{
 		MetaInfo: detek.MetaInfo{
 			ID:          "service_no_available_target",
@@ -20,57 +50,81 @@ func (*ServiceNoAvailableTarget) GetMeta() detek.DetectorInfo {
 			Labels:      []string{"kubernetes", "service"},
 		},
 		Required: detek.DependencyMeta{
-			collector.KeyK8sCoreV1EndpointList: {Type: detek.TypeOf(v1.EndpointsList{})},
+			collector.KeyK8sServiceEndpoints: {Type: detek.TypeOf([]collector.ServiceEndpoints{})},
 		},
 		Level: detek.Fatal,
 		IfHappened: detek.Description{
 			Explanation: "No available pods (or something else) for this Service. This Service is disabled right now.",
-			Solution:    "Check if Service Selector is properly being set. Or check if all your Pods are working right now.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Fatal: &detek.Description{
+				Explanation: fmt.Sprintf("Some of Services in critical namespaces %v have no available pods. The cluster may not be working properly.",
+					d.criticalNamespaces()),
+				Solution: solution,
+			},
+			Error: &detek.Description{
+				Explanation: "Some of Services have no available pods (or something else). Those Services are disabled right now.",
+				Solution:    solution,
+			},
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of Services in development namespaces %v have no available pods.", d.DevNamespacePatterns),
+				Solution:    solution,
+			},
 		},
 	}
 }
 
-func (*ServiceNoAvailableTarget) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
-	epList, err := detek.Typing[v1.EndpointsList](
-		ctx.Get(collector.KeyK8sCoreV1EndpointList, nil))
+func (d *ServiceNoAvailableTarget) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serviceEndpoints, err := detek.Typing[[]collector.ServiceEndpoints](
+		ctx.Get(collector.KeyK8sServiceEndpoints, nil))
 	if err != nil {
 		return nil, err
 	}
 
 	type Problem struct {
-		Name              string
-		Namespace         string
-		NotReadyEndpoints []string
+		Name                 string
+		Namespace            string
+		Level                detek.SeverityLevel
+		NotReadyEndpoints    []string
+		TerminatingEndpoints []string `json:",omitempty"`
 	}
 	problems := []Problem{}
+	observed := detek.Normal
+	source := collector.EndpointSourceEndpointSlice
 
-	for _, ep := range epList.Items {
-		for _, sub := range ep.Subsets {
-			if len(sub.Addresses) == 0 {
-				notReadies := []string{}
-				for _, addr := range sub.NotReadyAddresses {
-					text := addr.IP
-					if ref := addr.TargetRef; ref != nil {
-						text += fmt.Sprintf(" (%s/%s/%s)", ref.Kind, ref.Namespace, ref.Name)
-					}
-					notReadies = append(notReadies, text)
-				}
-				problems = append(problems, Problem{
-					Name:              ep.Name,
-					Namespace:         ep.Namespace,
-					NotReadyEndpoints: notReadies,
-				})
-			}
+	for _, se := range serviceEndpoints {
+		source = se.Source
+		if len(se.Endpoints) == 0 {
+			// no pods are selected, reported by service_selector_matches_no_pod
+			continue
+		}
+		states := endpointStatesOf(se)
+		if len(states.Serving) != 0 {
+			continue
+		}
+		level := d.levelOf(se.Namespace)
+		if level.ToInt() > observed.ToInt() {
+			observed = level
 		}
+		problems = append(problems, Problem{
+			Name:                 se.Name,
+			Namespace:            se.Namespace,
+			Level:                level,
+			NotReadyEndpoints:    states.NotReady,
+			TerminatingEndpoints: states.Terminating,
+		})
 	}
 	return &detek.ReportSpec{
-		HasPassed: len(problems) == 0,
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
 		Problem: detek.JSONableData{
 			Description: "Unavailable Service List",
 			Data:        problems,
 		},
 		Attachment: []detek.JSONableData{
-			{Description: "# of evaluated Endpoints", Data: len(epList.Items)},
+			{Description: "# of evaluated Services", Data: len(serviceEndpoints)},
+			{Description: "source of endpoints", Data: source},
 		},
 	}, nil
 }
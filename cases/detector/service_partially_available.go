@@ -1,11 +1,8 @@
 package detector
 
 import (
-	"fmt"
-
 	"github.com/kakao/detek/cases/collector"
 	"github.com/kakao/detek/pkg/detek"
-	v1 "k8s.io/api/core/v1"
 )
 
 var _ detek.Detector = &ServicePartiallyAvailable{}
@@ -20,7 +17,7 @@ func (*ServicePartiallyAvailable) GetMeta() detek.DetectorInfo {
 			Labels:      []string{"kubernetes", "service"},
 		},
 		Required: detek.DependencyMeta{
-			collector.KeyK8sCoreV1EndpointList: {Type: detek.TypeOf(v1.EndpointsList{})},
+			collector.KeyK8sServiceEndpoints: {Type: detek.TypeOf([]collector.ServiceEndpoints{})},
 		},
 		Level: detek.Error,
 		IfHappened: detek.Description{
@@ -31,8 +28,8 @@ func (*ServicePartiallyAvailable) GetMeta() detek.DetectorInfo {
 }
 
 func (*ServicePartiallyAvailable) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
-	epList, err := detek.Typing[v1.EndpointsList](
-		ctx.Get(collector.KeyK8sCoreV1EndpointList, nil))
+	serviceEndpoints, err := detek.Typing[[]collector.ServiceEndpoints](
+		ctx.Get(collector.KeyK8sServiceEndpoints, nil))
 	if err != nil {
 		return nil, err
 	}
@@ -43,24 +40,18 @@ func (*ServicePartiallyAvailable) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 		NotReadyEndpoints []string
 	}
 	problems := []Problem{}
+	source := collector.EndpointSourceEndpointSlice
 
-	for _, ep := range epList.Items {
-		for _, sub := range ep.Subsets {
-			if len(sub.NotReadyAddresses) != 0 {
-				notReadies := []string{}
-				for _, addr := range sub.NotReadyAddresses {
-					text := addr.IP
-					if ref := addr.TargetRef; ref != nil {
-						text += fmt.Sprintf(" (%s/%s/%s)", ref.Kind, ref.Namespace, ref.Name)
-					}
-					notReadies = append(notReadies, text)
-				}
-				problems = append(problems, Problem{
-					Name:              ep.Name,
-					Namespace:         ep.Namespace,
-					NotReadyEndpoints: notReadies,
-				})
-			}
+	for _, se := range serviceEndpoints {
+		source = se.Source
+		// terminating endpoints (e.g, during rolling updates) are expected to be not ready
+		notReadies := endpointStatesOf(se).NotReady
+		if len(notReadies) != 0 {
+			problems = append(problems, Problem{
+				Name:              se.Name,
+				Namespace:         se.Namespace,
+				NotReadyEndpoints: notReadies,
+			})
 		}
 	}
 	return &detek.ReportSpec{
@@ -70,7 +61,8 @@ func (*ServicePartiallyAvailable) Do(ctx detek.DetekContext) (*detek.ReportSpec,
 			Data:        problems,
 		},
 		Attachment: []detek.JSONableData{
-			{Description: "# of evaluated Endpoints", Data: len(epList.Items)},
+			{Description: "# of evaluated Services", Data: len(serviceEndpoints)},
+			{Description: "source of endpoints", Data: source},
 		},
 	}, nil
 }
@@ -0,0 +1,89 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ detek.Detector = &ServiceSelectingMultipleWorkloads{}
+
+type ServiceSelectingMultipleWorkloads struct{}
+
+// GetMeta implements detek.Detector
+func (*ServiceSelectingMultipleWorkloads) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "service_selecting_multiple_workloads",
+			Description: "Finding services whose selector matches pods of multiple workloads",
+			Labels:      []string{"kubernetes", "service"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1ServiceList: {Type: detek.TypeOf(v1.ServiceList{})},
+			collector.KeyK8sCoreV1PodList:     {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Selectors of some Services match pods of multiple workloads. Traffic may be sent to pods of an unintended workload.",
+			Solution:    "Check the selector of the Service is specific enough (e.g, add an \"app.kubernetes.io/name\" or \"app.kubernetes.io/component\" label).",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*ServiceSelectingMultipleWorkloads) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Selector  string
+		Workloads []string
+	}
+	problems := []Problem{}
+
+	pods := newPodsBySelector(podList)
+	for _, svc := range serviceList.Items {
+		workloads := map[string]bool{}
+		for _, po := range pods.selectedBy(svc) {
+			workloads[workloadOf(po)] = true
+		}
+		if len(workloads) < 2 {
+			continue
+		}
+		names := []string{}
+		for w := range workloads {
+			names = append(names, w)
+		}
+		sort.Strings(names)
+		problems = append(problems, Problem{
+			Namespace: svc.Namespace,
+			Name:      svc.Name,
+			Selector:  labels.FormatLabels(svc.Spec.Selector),
+			Workloads: names,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Services selecting pods of multiple workloads",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Services", Data: len(serviceList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,78 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ detek.Detector = &ServiceSelectorMatchesNoPod{}
+
+type ServiceSelectorMatchesNoPod struct{}
+
+// GetMeta implements detek.Detector
+func (*ServiceSelectorMatchesNoPod) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "service_selector_matches_no_pod",
+			Description: "Finding services whose selector matches no pods",
+			Labels:      []string{"kubernetes", "service"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1ServiceList: {Type: detek.TypeOf(v1.ServiceList{})},
+			collector.KeyK8sCoreV1PodList:     {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Selectors of some Services match no pods. Those Services have no endpoints at all.",
+			Solution:    "Check the selector of the Service has the same labels with the pod template of the workload (typos, stale version labels, etc). Or delete Services which are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*ServiceSelectorMatchesNoPod) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Selector  string
+	}
+	problems := []Problem{}
+
+	pods := newPodsBySelector(podList)
+	for _, svc := range serviceList.Items {
+		if !hasSelector(svc) {
+			continue
+		}
+		if len(pods.selectedBy(svc)) == 0 {
+			problems = append(problems, Problem{
+				Namespace: svc.Namespace,
+				Name:      svc.Name,
+				Selector:  labels.FormatLabels(svc.Spec.Selector),
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Services selecting no pods",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Services", Data: len(serviceList.Items)},
+		},
+	}, nil
+}
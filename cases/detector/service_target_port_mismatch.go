@@ -0,0 +1,138 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ detek.Detector = &ServiceTargetPortMismatch{}
+
+type ServiceTargetPortMismatch struct{}
+
+// GetMeta implements detek.Detector
+func (*ServiceTargetPortMismatch) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "service_target_port_mismatch",
+			Description: "Finding services whose targetPort is not exposed by any container of selected pods",
+			Labels:      []string{"kubernetes", "service", "pod"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1ServiceList: {Type: detek.TypeOf(v1.ServiceList{})},
+			collector.KeyK8sCoreV1PodList:     {Type: detek.TypeOf(v1.PodList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Services use named targetPorts which no container of selected pods exposes. Those ports have no endpoints.",
+			Solution:    "Fix targetPort of the Service, or add a port with the same name to containers of the workload.",
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: "Some of Services use numeric targetPorts which are not declared on any container of selected pods. Traffic may be sent to a wrong port.",
+				Solution:    "Check targetPort of the Service, and declare the port on containers of the workload.",
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*ServiceTargetPortMismatch) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace  string
+		Name       string
+		Port       string
+		TargetPort string
+		Level      detek.SeverityLevel
+		Reason     string
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+
+	pods := newPodsBySelector(podList)
+	for _, svc := range serviceList.Items {
+		selected := pods.selectedBy(svc)
+		if len(selected) == 0 {
+			// reported by service_selector_matches_no_pod
+			continue
+		}
+		for _, sp := range svc.Spec.Ports {
+			target := sp.TargetPort
+			if target.Type == intstr.Int && target.IntVal == 0 {
+				// defaults to the same value with the port
+				target = intstr.FromInt(int(sp.Port))
+			}
+			if isPortExposed(selected, target, sp.Protocol) {
+				continue
+			}
+			p := Problem{
+				Namespace:  svc.Namespace,
+				Name:       svc.Name,
+				Port:       fmt.Sprintf("%d/%s", sp.Port, sp.Protocol),
+				TargetPort: target.String(),
+			}
+			if target.Type == intstr.String {
+				p.Level = detek.Error
+				p.Reason = fmt.Sprintf("no container of %d selected pods exposes a port named %q", len(selected), target.StrVal)
+			} else {
+				p.Level = detek.Warn
+				p.Reason = fmt.Sprintf("no container of %d selected pods declares the port %d", len(selected), target.IntVal)
+			}
+			if p.Level.ToInt() > observed.ToInt() {
+				observed = p.Level
+			}
+			problems = append(problems, p)
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Service ports not exposed by selected pods",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Services", Data: len(serviceList.Items)},
+		},
+	}, nil
+}
+
+// isPortExposed returns whether any container of given pods exposes a given target port.
+func isPortExposed(pods []v1.Pod, target intstr.IntOrString, protocol v1.Protocol) bool {
+	if protocol == "" {
+		protocol = v1.ProtocolTCP
+	}
+	for _, po := range pods {
+		for _, co := range po.Spec.Containers {
+			for _, cp := range co.Ports {
+				cpProtocol := cp.Protocol
+				if cpProtocol == "" {
+					cpProtocol = v1.ProtocolTCP
+				}
+				if cpProtocol != protocol {
+					continue
+				}
+				if (target.Type == intstr.String && cp.Name == target.StrVal) ||
+					(target.Type == intstr.Int && cp.ContainerPort == target.IntVal) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package detector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kakao/detek/cases/collector"
+)
+
+func TestEndpointStatesOf(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []collector.ServiceEndpoint
+		want      endpointStates
+	}{
+		{
+			name: "ready",
+			endpoints: []collector.ServiceEndpoint{
+				{Address: "10.0.0.1", Ready: true, Serving: true},
+			},
+			want: endpointStates{Serving: []string{"10.0.0.1"}, Terminating: []string{}, NotReady: []string{}},
+		},
+		{
+			name: "terminating but still serving",
+			endpoints: []collector.ServiceEndpoint{
+				{Address: "10.0.0.1", Ready: false, Serving: true, Terminating: true},
+			},
+			want: endpointStates{Serving: []string{"10.0.0.1"}, Terminating: []string{}, NotReady: []string{}},
+		},
+		{
+			name: "terminating and not serving",
+			endpoints: []collector.ServiceEndpoint{
+				{Address: "10.0.0.1", Ready: false, Serving: false, Terminating: true},
+			},
+			want: endpointStates{Serving: []string{}, Terminating: []string{"10.0.0.1"}, NotReady: []string{}},
+		},
+		{
+			name: "mixed",
+			endpoints: []collector.ServiceEndpoint{
+				{Address: "10.0.0.1", Ready: false, Serving: true, Terminating: true},
+				{Address: "10.0.0.2", Ready: false, Serving: false, Terminating: true},
+				{Address: "10.0.0.3", Ready: false, Serving: false},
+			},
+			want: endpointStates{Serving: []string{"10.0.0.1"}, Terminating: []string{"10.0.0.2"}, NotReady: []string{"10.0.0.3"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := endpointStatesOf(collector.ServiceEndpoints{Name: "svc", Namespace: "default", Endpoints: tt.endpoints})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("endpointStatesOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package detector
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+)
+
+// RatioThreshold decides severity by the ratio of affected objects among all evaluated objects.
+type RatioThreshold struct {
+	// Fatal if (affected / total) >= Fatal
+	Fatal float64
+	// Error if (affected / total) >= Error, otherwise Warn
+	Error float64
+}
+
+var DefaultRatioThreshold = RatioThreshold{Fatal: 0.5, Error: 0.2}
+
+// LevelOf returns the severity for a given number of affected objects.
+// zero value of RatioThreshold will be treated as DefaultRatioThreshold.
+func (t RatioThreshold) LevelOf(affected, total int) detek.SeverityLevel {
+	t = t.orDefault()
+	if affected == 0 || total == 0 {
+		return detek.Normal
+	}
+	ratio := float64(affected) / float64(total)
+	switch {
+	case ratio >= t.Fatal:
+		return detek.Fatal
+	case ratio >= t.Error:
+		return detek.Error
+	default:
+		return detek.Warn
+	}
+}
+
+// Describe declares descriptions of levels which LevelOf may return.
+func (t RatioThreshold) Describe(desc detek.Description) detek.SeverityLevelDescription {
+	t = t.orDefault()
+	withRatio := func(format string, a ...any) *detek.Description {
+		return &detek.Description{
+			Explanation: desc.Explanation + " " + fmt.Sprintf(format, a...),
+			Solution:    desc.Solution,
+		}
+	}
+	return detek.SeverityLevelDescription{
+		Fatal: withRatio("(%.0f%% or more of them are affected)", t.Fatal*100),
+		Error: withRatio("(%.0f%% ~ %.0f%% of them are affected)", t.Error*100, t.Fatal*100),
+		Warn:  withRatio("(less than %.0f%% of them are affected)", t.Error*100),
+	}
+}
+
+func (t RatioThreshold) orDefault() RatioThreshold {
+	if t == (RatioThreshold{}) {
+		return DefaultRatioThreshold
+	}
+	return t
+}
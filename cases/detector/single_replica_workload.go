@@ -0,0 +1,119 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &SingleReplicaWorkload{}
+
+type SingleReplicaWorkload struct {
+	// if true, only workloads selected by Services will be reported.
+	ServiceBackedOnly bool
+}
+
+// GetMeta implements detek.Detector
+func (d *SingleReplicaWorkload) GetMeta() detek.DetectorInfo {
+	description := "Finding Deployments and StatefulSets running a single replica"
+	if d.ServiceBackedOnly {
+		description = "Finding Deployments and StatefulSets backing Services with a single replica"
+	}
+	solution := "Run 2 or more replicas for workloads which should be available all the time."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "single_replica_workload",
+			Description: description,
+			Labels:      []string{"kubernetes", "workload", "availability"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAppsV1DeploymentList:  {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList: {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+			collector.KeyK8sCoreV1ServiceList:     {Type: detek.TypeOf(v1.ServiceList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads are running a single replica. They will be unavailable while their node is drained (e.g, during upgrades) or down.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Error: &detek.Description{
+				Explanation: "Some of workloads backing Services are running a single replica. Those Services will be down while the node is drained (e.g, during upgrades) or down.",
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *SingleReplicaWorkload) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	deploymentList, err := detek.Typing[appsv1.DeploymentList](
+		ctx.Get(collector.KeyK8sAppsV1DeploymentList, nil))
+	if err != nil {
+		return nil, err
+	}
+	statefulSetList, err := detek.Typing[appsv1.StatefulSetList](
+		ctx.Get(collector.KeyK8sAppsV1StatefulSetList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace string
+		Kind      string
+		Name      string
+		Services  []string `json:",omitempty"`
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+
+	check := func(namespace, kind, name string, replicas *int32, template v1.PodTemplateSpec) {
+		if replicasOf(replicas) != 1 {
+			return
+		}
+		services := servicesSelecting(serviceList, namespace, template.Labels)
+		level := detek.Warn
+		if len(services) != 0 {
+			level = detek.Error
+		} else if d.ServiceBackedOnly {
+			return
+		}
+		if level.ToInt() > observed.ToInt() {
+			observed = level
+		}
+		problems = append(problems, Problem{namespace, kind, name, services})
+	}
+	for _, deploy := range deploymentList.Items {
+		check(deploy.Namespace, "Deployment", deploy.Name, deploy.Spec.Replicas, deploy.Spec.Template)
+	}
+	for _, sts := range statefulSetList.Items {
+		check(sts.Namespace, "StatefulSet", sts.Name, sts.Spec.Replicas, sts.Spec.Template)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Workloads running a single replica",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Deployments", Data: len(deploymentList.Items)},
+			{Description: "# of evaluated StatefulSets", Data: len(statefulSetList.Items)},
+		},
+	}, nil
+}
+
+// replicasOf returns the number of desired replicas. (1 if not set)
+func replicasOf(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
@@ -0,0 +1,39 @@
+package detector
+
+import (
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+const (
+	annDefaultStorageClass     = "storageclass.kubernetes.io/is-default-class"
+	annBetaDefaultStorageClass = "storageclass.beta.kubernetes.io/is-default-class"
+	annBetaStorageClass        = "volume.beta.kubernetes.io/storage-class"
+)
+
+func isDefaultStorageClass(sc storagev1.StorageClass) bool {
+	return sc.Annotations[annDefaultStorageClass] == "true" || sc.Annotations[annBetaDefaultStorageClass] == "true"
+}
+
+// storageClassNameOf returns the name of the StorageClass requested by a given claim. (nil if not set)
+func storageClassNameOf(pvc v1.PersistentVolumeClaim) *string {
+	if name, ok := pvc.Annotations[annBetaStorageClass]; ok {
+		return &name
+	}
+	return pvc.Spec.StorageClassName
+}
+
+// claimsUsedBy returns names of PersistentVolumeClaims used by a given pod.
+func claimsUsedBy(po v1.Pod) []string {
+	result := []string{}
+	for _, vol := range po.Spec.Volumes {
+		switch {
+		case vol.PersistentVolumeClaim != nil:
+			result = append(result, vol.PersistentVolumeClaim.ClaimName)
+		case vol.Ephemeral != nil:
+			// generic ephemeral volumes are named "<pod>-<volume>"
+			result = append(result, po.Name+"-"+vol.Name)
+		}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+var _ detek.Detector = &StuckVolumeAttachment{}
+
+type StuckVolumeAttachment struct {
+	// VolumeAttachments detaching longer than this will be reported. (default: 10m)
+	MaxDetachingDuration time.Duration
+}
+
+func (d *StuckVolumeAttachment) maxDetachingDuration() time.Duration {
+	if d.MaxDetachingDuration == 0 {
+		return 10 * time.Minute
+	}
+	return d.MaxDetachingDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *StuckVolumeAttachment) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "stuck_volume_attachment",
+			Description: fmt.Sprintf("Finding VolumeAttachments failed to detach, or detaching longer than %s", d.maxDetachingDuration()),
+			Labels:      []string{"kubernetes", "storage", "volumeattachment"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sStorageV1VolumeAttachmentList: {Type: detek.TypeOf(storagev1.VolumeAttachmentList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of volumes can not be detached from nodes. Pods using those volumes can not be started on the other nodes (Multi-Attach error).",
+			Solution:    "Check the detach error, logs of the CSI driver (attacher) and the node. If the node is gone, force-detaching may be needed in the storage provider.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *StuckVolumeAttachment) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	vaList, err := detek.Typing[storagev1.VolumeAttachmentList](
+		ctx.Get(collector.KeyK8sStorageV1VolumeAttachmentList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name             string
+		Attacher         string
+		Node             string
+		PersistentVolume string `json:",omitempty"`
+		DetachingFor     string `json:",omitempty"`
+		DetachError      string `json:",omitempty"`
+	}
+	problems := []Problem{}
+
+	now := time.Now()
+	for _, va := range vaList.Items {
+		p := Problem{
+			Name:     va.Name,
+			Attacher: va.Spec.Attacher,
+			Node:     va.Spec.NodeName,
+		}
+		if pv := va.Spec.Source.PersistentVolumeName; pv != nil {
+			p.PersistentVolume = *pv
+		}
+		if de := va.Status.DetachError; de != nil {
+			p.DetachError = de.Message
+		}
+		if ts := va.DeletionTimestamp; ts != nil {
+			if detachingFor := now.Sub(ts.Time); detachingFor >= d.maxDetachingDuration() {
+				p.DetachingFor = detachingFor.Truncate(time.Second).String()
+			}
+		}
+		if p.DetachError != "" || p.DetachingFor != "" {
+			problems = append(problems, p)
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "VolumeAttachments stuck detaching",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated VolumeAttachments", Data: len(vaList.Items)},
+		},
+	}, nil
+}
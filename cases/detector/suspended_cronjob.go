@@ -0,0 +1,76 @@
+package detector
+
+import (
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+var _ detek.Detector = &SuspendedCronJob{}
+
+type SuspendedCronJob struct{}
+
+// GetMeta implements detek.Detector
+func (*SuspendedCronJob) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "suspended_cronjob",
+			Description: "Finding suspended CronJobs",
+			Labels:      []string{"kubernetes", "batch", "cronjob"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sBatchV1CronJobList: {Type: detek.TypeOf(batchv1.CronJobList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of CronJobs are suspended, and will not run. They may have been suspended temporarily (e.g, during maintenance) and forgotten.",
+			Solution:    "Resume those CronJobs (`kubectl patch cronjob <name> -p '{\"spec\":{\"suspend\":false}}'`), or delete them if they are not used anymore.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*SuspendedCronJob) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	cronJobList, err := detek.Typing[batchv1.CronJobList](
+		ctx.Get(collector.KeyK8sBatchV1CronJobList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Namespace        string
+		Name             string
+		Schedule         string
+		LastScheduleTime string
+	}
+	problems := []Problem{}
+
+	for _, cj := range cronJobList.Items {
+		if cj.Spec.Suspend == nil || !*cj.Spec.Suspend {
+			continue
+		}
+		lastSchedule := "(never)"
+		if t := cj.Status.LastScheduleTime; t != nil {
+			lastSchedule = t.Time.Format(time.RFC3339)
+		}
+		problems = append(problems, Problem{
+			Namespace:        cj.Namespace,
+			Name:             cj.Name,
+			Schedule:         cj.Spec.Schedule,
+			LastScheduleTime: lastSchedule,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Suspended CronJobs",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated CronJobs", Data: len(cronJobList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,180 @@
+package detector
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ detek.Detector = &TerminatingNamespace{}
+
+type TerminatingNamespace struct {
+	// namespaces terminating longer than this will be reported. (default: 10m)
+	MaxTerminatingDuration time.Duration
+}
+
+func (d *TerminatingNamespace) maxTerminatingDuration() time.Duration {
+	if d.MaxTerminatingDuration == 0 {
+		return 10 * time.Minute
+	}
+	return d.MaxTerminatingDuration
+}
+
+// GetMeta implements detek.Detector
+func (d *TerminatingNamespace) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "terminating_namespace",
+			Description: fmt.Sprintf("Finding namespaces stuck in a 'Terminating' status longer than %s, with resources blocking the deletion", d.maxTerminatingDuration()),
+			Labels:      []string{"kubernetes", "namespace", "finalizer"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1NamespaceList:             {Type: detek.TypeOf(v1.NamespaceList{})},
+			collector.KeyK8sCoreV1PodList:                   {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sCoreV1PersistentVolumeClaimList: {Type: detek.TypeOf(v1.PersistentVolumeClaimList{})},
+			// objects below are used to find more blockers, only if collected
+			collector.KeyK8sCoreV1ServiceList:       {Type: detek.TypeOf(v1.ServiceList{}), IsOptional: true},
+			collector.KeyK8sAppsV1DeploymentList:    {Type: detek.TypeOf(appsv1.DeploymentList{}), IsOptional: true},
+			collector.KeyK8sAppsV1StatefulSetList:   {Type: detek.TypeOf(appsv1.StatefulSetList{}), IsOptional: true},
+			collector.KeyK8sBatchV1JobList:          {Type: detek.TypeOf(batchv1.JobList{}), IsOptional: true},
+			collector.KeyK8sBatchV1CronJobList:      {Type: detek.TypeOf(batchv1.CronJobList{}), IsOptional: true},
+			collector.KeyK8sNetworkingV1IngressList: {Type: detek.TypeOf(networkingv1.IngressList{}), IsOptional: true},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of namespaces can not be deleted. Usually, some resources in the namespace have finalizers which are never removed (e.g, the controller is already deleted), or an aggregated API is unavailable.",
+			Solution:    "Check the blocking resources and their finalizers, and fix (or remove) the controller handling them. Removing finalizers by hand may leave external resources orphaned.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *TerminatingNamespace) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	nsList, err := detek.Typing[v1.NamespaceList](
+		ctx.Get(collector.KeyK8sCoreV1NamespaceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pvcList, err := detek.Typing[v1.PersistentVolumeClaimList](
+		ctx.Get(collector.KeyK8sCoreV1PersistentVolumeClaimList, nil))
+	if err != nil {
+		return nil, err
+	}
+	svcList, err := optionalList[v1.ServiceList](ctx, collector.KeyK8sCoreV1ServiceList)
+	if err != nil {
+		return nil, err
+	}
+	deployList, err := optionalList[appsv1.DeploymentList](ctx, collector.KeyK8sAppsV1DeploymentList)
+	if err != nil {
+		return nil, err
+	}
+	stsList, err := optionalList[appsv1.StatefulSetList](ctx, collector.KeyK8sAppsV1StatefulSetList)
+	if err != nil {
+		return nil, err
+	}
+	jobList, err := optionalList[batchv1.JobList](ctx, collector.KeyK8sBatchV1JobList)
+	if err != nil {
+		return nil, err
+	}
+	cronJobList, err := optionalList[batchv1.CronJobList](ctx, collector.KeyK8sBatchV1CronJobList)
+	if err != nil {
+		return nil, err
+	}
+	ingList, err := optionalList[networkingv1.IngressList](ctx, collector.KeyK8sNetworkingV1IngressList)
+	if err != nil {
+		return nil, err
+	}
+
+	// objects being deleted or having finalizers, which are common blockers (e.g, "Pod/foo [example.com/cleanup] (deleting)")
+	blockers := map[string][]string{}
+	addBlocker := func(kind string, obj metav1.Object) {
+		if len(obj.GetFinalizers()) == 0 && obj.GetDeletionTimestamp() == nil {
+			return
+		}
+		blocker := fmt.Sprintf("%s/%s %v", kind, obj.GetName(), obj.GetFinalizers())
+		if obj.GetDeletionTimestamp() != nil {
+			blocker += " (deleting)"
+		}
+		blockers[obj.GetNamespace()] = append(blockers[obj.GetNamespace()], blocker)
+	}
+	for i := range podList.Items {
+		addBlocker("Pod", &podList.Items[i])
+	}
+	for i := range pvcList.Items {
+		addBlocker("PersistentVolumeClaim", &pvcList.Items[i])
+	}
+	for i := range svcList.Items {
+		addBlocker("Service", &svcList.Items[i])
+	}
+	for i := range deployList.Items {
+		addBlocker("Deployment", &deployList.Items[i])
+	}
+	for i := range stsList.Items {
+		addBlocker("StatefulSet", &stsList.Items[i])
+	}
+	for i := range jobList.Items {
+		addBlocker("Job", &jobList.Items[i])
+	}
+	for i := range cronJobList.Items {
+		addBlocker("CronJob", &cronJobList.Items[i])
+	}
+	for i := range ingList.Items {
+		addBlocker("Ingress", &ingList.Items[i])
+	}
+
+	type Problem struct {
+		Name           string
+		TerminatingFor string
+		Finalizers     []v1.FinalizerName `json:",omitempty"`
+		BlockedBy      []string
+	}
+	problems := []Problem{}
+
+	now := time.Now()
+	for _, ns := range nsList.Items {
+		if ns.Status.Phase != v1.NamespaceTerminating || ns.DeletionTimestamp == nil {
+			continue
+		}
+		terminatingFor := now.Sub(ns.DeletionTimestamp.Time)
+		if terminatingFor < d.maxTerminatingDuration() {
+			continue
+		}
+		blockedBy := []string{}
+		for _, cond := range ns.Status.Conditions {
+			// e.g, NamespaceContentRemaining, NamespaceFinalizersRemaining, NamespaceDeletionDiscoveryFailure
+			if cond.Status == v1.ConditionTrue {
+				blockedBy = append(blockedBy, fmt.Sprintf("%s: %s", cond.Type, cond.Message))
+			}
+		}
+		blockedBy = append(blockedBy, blockers[ns.Name]...)
+		problems = append(problems, Problem{
+			Name:           ns.Name,
+			TerminatingFor: terminatingFor.Truncate(time.Second).String(),
+			Finalizers:     ns.Spec.Finalizers,
+			BlockedBy:      blockedBy,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Namespaces stuck in Terminating",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Namespaces", Data: len(nsList.Items)},
+		},
+	}, nil
+}
@@ -0,0 +1,170 @@
+package detector
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &TLSCertificateExpiring{}
+
+type TLSCertificateExpiring struct {
+	// certificates expiring within this are reported as Warn. (default: 30d)
+	WarnBefore time.Duration
+	// certificates expiring within this are reported as Error. (default: 7d)
+	// expired certificates are always reported as Fatal.
+	ErrorBefore time.Duration
+}
+
+func (d *TLSCertificateExpiring) warnBefore() time.Duration {
+	if d.WarnBefore == 0 {
+		return 30 * 24 * time.Hour
+	}
+	return d.WarnBefore
+}
+
+func (d *TLSCertificateExpiring) errorBefore() time.Duration {
+	if d.ErrorBefore == 0 {
+		return 7 * 24 * time.Hour
+	}
+	return d.ErrorBefore
+}
+
+// levelOf returns the severity of a certificate, which will be expired after a given duration.
+func (d *TLSCertificateExpiring) levelOf(remaining time.Duration) detek.SeverityLevel {
+	switch {
+	case remaining <= 0:
+		return detek.Fatal
+	case remaining <= d.errorBefore():
+		return detek.Error
+	case remaining <= d.warnBefore():
+		return detek.Warn
+	default:
+		return detek.Normal
+	}
+}
+
+// GetMeta implements detek.Detector
+func (d *TLSCertificateExpiring) GetMeta() detek.DetectorInfo {
+	solution := "Renew those certificates (check the issuer, e.g, cert-manager, if certificates are managed automatically)."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "tls_certificate_expiring",
+			Description: fmt.Sprintf("Finding certificates in TLS secrets expired, or expiring within %s", formatDays(d.warnBefore())),
+			Labels:      []string{"kubernetes", "secret", "tls", "certificate"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sTLSCertificates:         {Type: detek.TypeOf([]collector.TLSCertificate{})},
+			collector.KeyK8sNetworkingV1IngressList: {Type: detek.TypeOf(networkingv1.IngressList{}), IsOptional: true},
+		},
+		Level: detek.Fatal,
+		IfHappened: detek.Description{
+			Explanation: "Some of certificates are expired. Clients will refuse to connect to hosts using them.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Error: &detek.Description{
+				Explanation: fmt.Sprintf("Some of certificates will be expired within %s.", formatDays(d.errorBefore())),
+				Solution:    solution,
+			},
+			Warn: &detek.Description{
+				Explanation: fmt.Sprintf("Some of certificates will be expired within %s.", formatDays(d.warnBefore())),
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *TLSCertificateExpiring) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	certs, err := detek.Typing[[]collector.TLSCertificate](
+		ctx.Get(collector.KeyK8sTLSCertificates, nil))
+	if err != nil {
+		return nil, err
+	}
+	// if ingresses are not available, UsedBy will be empty
+	ingressList, err := optionalList[networkingv1.IngressList](ctx, collector.KeyK8sNetworkingV1IngressList)
+	if err != nil {
+		return nil, err
+	}
+	usedBy := map[types.NamespacedName][]string{}
+	for _, ing := range ingressList.Items {
+		for _, tls := range ing.Spec.TLS {
+			key := types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName}
+			usedBy[key] = append(usedBy[key], "Ingress/"+ing.Name)
+		}
+	}
+
+	type Problem struct {
+		Namespace string
+		Name      string
+		Level     detek.SeverityLevel
+		NotAfter  string
+		Remaining string
+		DNSNames  []string
+		Issuer    string
+		UsedBy    []string `json:",omitempty"`
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+	invalid := []string{}
+
+	now := time.Now()
+	for _, cert := range certs {
+		if cert.Error != "" {
+			invalid = append(invalid, fmt.Sprintf("%s/%s: %s", cert.Namespace, cert.Name, cert.Error))
+			continue
+		}
+		remaining := cert.NotAfter.Sub(now)
+		level := d.levelOf(remaining)
+		if level == detek.Normal {
+			continue
+		}
+		if level.ToInt() > observed.ToInt() {
+			observed = level
+		}
+		remainingText := formatDays(remaining)
+		if remaining <= 0 {
+			remainingText = "expired " + formatDays(-remaining) + " ago"
+		}
+		problems = append(problems, Problem{
+			Namespace: cert.Namespace,
+			Name:      cert.Name,
+			Level:     level,
+			NotAfter:  cert.NotAfter.UTC().Format(time.RFC3339),
+			Remaining: remainingText,
+			DNSNames:  cert.DNSNames,
+			Issuer:    cert.Issuer,
+			UsedBy:    usedBy[types.NamespacedName{Namespace: cert.Namespace, Name: cert.Name}],
+		})
+	}
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].NotAfter < problems[j].NotAfter
+	})
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Expired or expiring certificates",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated certificates", Data: len(certs)},
+			{Description: "TLS secrets which can not be parsed", Data: invalid},
+		},
+	}, nil
+}
+
+// formatDays formats a duration in days. (e.g, "7d", "12h" if less than a day)
+func formatDays(d time.Duration) string {
+	if d < 24*time.Hour {
+		return d.Truncate(time.Minute).String()
+	}
+	return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+}
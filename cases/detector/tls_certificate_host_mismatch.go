@@ -0,0 +1,98 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ detek.Detector = &TLSCertificateHostMismatch{}
+
+type TLSCertificateHostMismatch struct{}
+
+// GetMeta implements detek.Detector
+func (*TLSCertificateHostMismatch) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "tls_certificate_host_mismatch",
+			Description: "Finding ingress hosts not covered by SANs of their TLS certificates",
+			Labels:      []string{"kubernetes", "ingress", "tls", "certificate"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sNetworkingV1IngressList: {Type: detek.TypeOf(networkingv1.IngressList{})},
+			collector.KeyK8sTLSCertificates:         {Type: detek.TypeOf([]collector.TLSCertificate{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of Ingress hosts are not covered by the certificate of their TLS secrets. Clients will refuse to connect to those hosts.",
+			Solution:    "Reissue the certificate with those hosts in SANs (subjectAltName), or fix hosts of the TLS section of the Ingress.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*TLSCertificateHostMismatch) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	ingressList, err := detek.Typing[networkingv1.IngressList](
+		ctx.Get(collector.KeyK8sNetworkingV1IngressList, nil))
+	if err != nil {
+		return nil, err
+	}
+	certs, err := detek.Typing[[]collector.TLSCertificate](
+		ctx.Get(collector.KeyK8sTLSCertificates, nil))
+	if err != nil {
+		return nil, err
+	}
+	certOf := map[types.NamespacedName]collector.TLSCertificate{}
+	for _, cert := range certs {
+		certOf[types.NamespacedName{Namespace: cert.Namespace, Name: cert.Name}] = cert
+	}
+
+	type Problem struct {
+		Namespace  string
+		Name       string
+		SecretName string
+		Host       string
+		DNSNames   []string
+	}
+	problems := []Problem{}
+
+	for _, ing := range ingressList.Items {
+		for _, tls := range ing.Spec.TLS {
+			cert, ok := certOf[types.NamespacedName{Namespace: ing.Namespace, Name: tls.SecretName}]
+			if !ok || cert.Error != "" {
+				// reported by ingress_tls_secret_missing (or can not be evaluated)
+				continue
+			}
+			for _, host := range tls.Hosts {
+				covered := false
+				for _, name := range cert.DNSNames {
+					if matchesCertificateHost(name, host) {
+						covered = true
+						break
+					}
+				}
+				if !covered {
+					problems = append(problems, Problem{
+						Namespace:  ing.Namespace,
+						Name:       ing.Name,
+						SecretName: tls.SecretName,
+						Host:       host,
+						DNSNames:   cert.DNSNames,
+					})
+				}
+			}
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Ingress hosts not covered by certificates",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Ingresses", Data: len(ingressList.Items)},
+		},
+	}, nil
+}
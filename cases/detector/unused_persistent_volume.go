@@ -0,0 +1,84 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ detek.Detector = &UnusedPersistentVolume{}
+
+type UnusedPersistentVolume struct{}
+
+// GetMeta implements detek.Detector
+func (*UnusedPersistentVolume) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "unused_persistent_volume",
+			Description: "Finding PersistentVolumes in a 'Released' or 'Failed' status",
+			Labels:      []string{"kubernetes", "storage", "pv"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PersistentVolumeList: {Type: detek.TypeOf(v1.PersistentVolumeList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of PersistentVolumes are not bound to any claim anymore, but their storage is still allocated (and charged).",
+			Solution:    "Back up data if needed, and delete those PersistentVolumes (and the underlying storage). Check the reclaim policy of the StorageClass, if they are created dynamically.",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*UnusedPersistentVolume) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	pvList, err := detek.Typing[v1.PersistentVolumeList](
+		ctx.Get(collector.KeyK8sCoreV1PersistentVolumeList, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	type Problem struct {
+		Name          string
+		Phase         v1.PersistentVolumePhase
+		Capacity      string
+		StorageClass  string
+		ReclaimPolicy v1.PersistentVolumeReclaimPolicy
+		LastClaim     string `json:",omitempty"`
+		Message       string `json:",omitempty"`
+	}
+	problems := []Problem{}
+	wasted := resource.Quantity{}
+
+	for _, pv := range pvList.Items {
+		if pv.Status.Phase != v1.VolumeReleased && pv.Status.Phase != v1.VolumeFailed {
+			continue
+		}
+		capacity := pv.Spec.Capacity[v1.ResourceStorage]
+		wasted.Add(capacity)
+		p := Problem{
+			Name:          pv.Name,
+			Phase:         pv.Status.Phase,
+			Capacity:      capacity.String(),
+			StorageClass:  pv.Spec.StorageClassName,
+			ReclaimPolicy: pv.Spec.PersistentVolumeReclaimPolicy,
+			Message:       pv.Status.Message,
+		}
+		if ref := pv.Spec.ClaimRef; ref != nil {
+			p.LastClaim = ref.Namespace + "/" + ref.Name
+		}
+		problems = append(problems, p)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Released or failed PersistentVolumes",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated PersistentVolumes", Data: len(pvList.Items)},
+			{Description: "total capacity of unused PersistentVolumes", Data: wasted.String()},
+		},
+	}, nil
+}
@@ -0,0 +1,71 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// admissionWebhook is a validating (or mutating) webhook, with its configuration.
+type admissionWebhook struct {
+	Kind              string
+	Configuration     string
+	Name              string
+	FailurePolicy     admissionv1.FailurePolicyType
+	ClientConfig      admissionv1.WebhookClientConfig
+	NamespaceSelector *metav1.LabelSelector
+	Rules             []admissionv1.RuleWithOperations
+}
+
+func (w admissionWebhook) String() string {
+	return w.Kind + "/" + w.Configuration + "/" + w.Name
+}
+
+// loadWebhooks returns every validating and mutating webhook.
+func loadWebhooks(ctx detek.DetekContext) ([]admissionWebhook, error) {
+	validatingList, err := detek.Typing[admissionv1.ValidatingWebhookConfigurationList](
+		ctx.Get(collector.KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList, nil))
+	if err != nil {
+		return nil, err
+	}
+	mutatingList, err := detek.Typing[admissionv1.MutatingWebhookConfigurationList](
+		ctx.Get(collector.KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList, nil))
+	if err != nil {
+		return nil, err
+	}
+	result := []admissionWebhook{}
+	for _, c := range validatingList.Items {
+		for _, w := range c.Webhooks {
+			result = append(result, admissionWebhook{
+				"ValidatingWebhookConfiguration", c.Name, w.Name, failurePolicyOf(w.FailurePolicy), w.ClientConfig, w.NamespaceSelector, w.Rules,
+			})
+		}
+	}
+	for _, c := range mutatingList.Items {
+		for _, w := range c.Webhooks {
+			result = append(result, admissionWebhook{
+				"MutatingWebhookConfiguration", c.Name, w.Name, failurePolicyOf(w.FailurePolicy), w.ClientConfig, w.NamespaceSelector, w.Rules,
+			})
+		}
+	}
+	return result, nil
+}
+
+// failurePolicyOf returns the failure policy. (default is "Fail" in admissionregistration.k8s.io/v1)
+func failurePolicyOf(policy *admissionv1.FailurePolicyType) admissionv1.FailurePolicyType {
+	if policy == nil {
+		return admissionv1.Fail
+	}
+	return *policy
+}
+
+// isNamespacedWebhook returns whether the webhook intercepts requests for namespaced resources.
+func isNamespacedWebhook(w admissionWebhook) bool {
+	for _, rule := range w.Rules {
+		if rule.Scope == nil || *rule.Scope != admissionv1.ClusterScope {
+			return true
+		}
+	}
+	return false
+}
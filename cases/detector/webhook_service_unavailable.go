@@ -0,0 +1,136 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &WebhookServiceUnavailable{}
+
+type WebhookServiceUnavailable struct{}
+
+// GetMeta implements detek.Detector
+func (*WebhookServiceUnavailable) GetMeta() detek.DetectorInfo {
+	solution := "Check pods of the webhook Service (`kubectl get endpointslices -n <namespace> -l kubernetes.io/service-name=<name>`). " +
+		"If the webhook is not used anymore, delete its configuration."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "webhook_service_unavailable",
+			Description: "Finding admission webhooks pointing at Services with no ready endpoints",
+			Labels:      []string{"kubernetes", "webhook", "admission", "service"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList: {Type: detek.TypeOf(admissionv1.ValidatingWebhookConfigurationList{})},
+			collector.KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList:   {Type: detek.TypeOf(admissionv1.MutatingWebhookConfigurationList{})},
+			collector.KeyK8sCoreV1ServiceList:                                         {Type: detek.TypeOf(v1.ServiceList{})},
+			collector.KeyK8sServiceEndpoints:                                          {Type: detek.TypeOf([]collector.ServiceEndpoints{})},
+		},
+		Level: detek.Fatal,
+		IfHappened: detek.Description{
+			Explanation: "Some of admission webhooks with 'failurePolicy: Fail' have no ready endpoints. " +
+				"Every request intercepted by those webhooks is rejected, which can freeze the cluster (e.g, pods can not be created).",
+			Solution: solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: "Some of admission webhooks with 'failurePolicy: Ignore' have no ready endpoints. " +
+					"Requests intercepted by those webhooks are not validated (or mutated), and slowed down until the webhook times out.",
+				Solution: solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*WebhookServiceUnavailable) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	webhooks, err := loadWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	serviceList, err := detek.Typing[v1.ServiceList](
+		ctx.Get(collector.KeyK8sCoreV1ServiceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	serviceEndpoints, err := detek.Typing[[]collector.ServiceEndpoints](
+		ctx.Get(collector.KeyK8sServiceEndpoints, nil))
+	if err != nil {
+		return nil, err
+	}
+	services := map[string]v1.Service{}
+	for _, svc := range serviceList.Items {
+		services[svc.Namespace+"/"+svc.Name] = svc
+	}
+	endpoints := map[string]collector.ServiceEndpoints{}
+	for _, se := range serviceEndpoints {
+		endpoints[se.Namespace+"/"+se.Name] = se
+	}
+
+	type Problem struct {
+		Webhook       string
+		FailurePolicy admissionv1.FailurePolicyType
+		Service       string
+		Reason        string
+		Level         detek.SeverityLevel
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+	evaluated := 0
+
+	for _, w := range webhooks {
+		ref := w.ClientConfig.Service
+		if ref == nil {
+			// pointing at an URL, can not be evaluated
+			continue
+		}
+		evaluated++
+		key := ref.Namespace + "/" + ref.Name
+		reason := ""
+		if svc, ok := services[key]; !ok {
+			reason = "Service not found"
+		} else if svc.Spec.Type == v1.ServiceTypeExternalName {
+			continue
+		} else if !hasReadyEndpoint(endpoints[key]) {
+			reason = "no ready endpoints"
+		} else {
+			continue
+		}
+		level := detek.Fatal
+		if w.FailurePolicy == admissionv1.Ignore {
+			level = detek.Warn
+		}
+		if level.ToInt() > observed.ToInt() {
+			observed = level
+		}
+		problems = append(problems, Problem{
+			Webhook:       w.String(),
+			FailurePolicy: w.FailurePolicy,
+			Service:       key,
+			Reason:        reason,
+			Level:         level,
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Admission webhooks pointing at unavailable Services",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated webhooks (pointing at Services)", Data: evaluated},
+		},
+	}, nil
+}
+
+func hasReadyEndpoint(se collector.ServiceEndpoints) bool {
+	for _, ep := range se.Endpoints {
+		if ep.Ready && !ep.Terminating {
+			return true
+		}
+	}
+	return false
+}
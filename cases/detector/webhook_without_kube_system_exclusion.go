@@ -0,0 +1,95 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var _ detek.Detector = &WebhookWithoutKubeSystemExclusion{}
+
+type WebhookWithoutKubeSystemExclusion struct{}
+
+// GetMeta implements detek.Detector
+func (*WebhookWithoutKubeSystemExclusion) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "webhook_without_kube_system_exclusion",
+			Description: "Finding admission webhooks with 'failurePolicy: Fail', whose namespaceSelector does not exclude kube-system",
+			Labels:      []string{"kubernetes", "webhook", "admission"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAdmissionRegistrationV1ValidatingWebhookConfigurationList: {Type: detek.TypeOf(admissionv1.ValidatingWebhookConfigurationList{})},
+			collector.KeyK8sAdmissionRegistrationV1MutatingWebhookConfigurationList:   {Type: detek.TypeOf(admissionv1.MutatingWebhookConfigurationList{})},
+			collector.KeyK8sCoreV1NamespaceList:                                       {Type: detek.TypeOf(v1.NamespaceList{})},
+		},
+		Level: detek.Fatal,
+		IfHappened: detek.Description{
+			Explanation: "Some of admission webhooks with 'failurePolicy: Fail' intercept requests in kube-system. " +
+				"If the webhook is down, system components (including the webhook itself, if it runs in kube-system) can not be recovered.",
+			Solution: "Exclude kube-system with namespaceSelector of those webhooks " +
+				"(e.g, `matchExpressions: [{key: kubernetes.io/metadata.name, operator: NotIn, values: [kube-system]}]`).",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*WebhookWithoutKubeSystemExclusion) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	webhooks, err := loadWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nsList, err := detek.Typing[v1.NamespaceList](
+		ctx.Get(collector.KeyK8sCoreV1NamespaceList, nil))
+	if err != nil {
+		return nil, err
+	}
+	// labels of kube-system. ("kubernetes.io/metadata.name" is set automatically since v1.21)
+	kubeSystem := labels.Set{v1.LabelMetadataName: metav1.NamespaceSystem}
+	for _, ns := range nsList.Items {
+		if ns.Name == metav1.NamespaceSystem {
+			kubeSystem = labels.Set(ns.Labels)
+		}
+	}
+
+	type Problem struct {
+		Webhook           string
+		NamespaceSelector *metav1.LabelSelector
+	}
+	problems := []Problem{}
+	evaluated := 0
+
+	for _, w := range webhooks {
+		if w.FailurePolicy != admissionv1.Fail || !isNamespacedWebhook(w) {
+			continue
+		}
+		evaluated++
+		// empty (or nil) selector matches every namespace
+		selector := labels.Everything()
+		if w.NamespaceSelector != nil {
+			if selector, err = metav1.LabelSelectorAsSelector(w.NamespaceSelector); err != nil {
+				continue
+			}
+		}
+		if selector.Matches(kubeSystem) {
+			problems = append(problems, Problem{
+				Webhook:           w.String(),
+				NamespaceSelector: w.NamespaceSelector,
+			})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Admission webhooks intercepting requests in kube-system",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated webhooks (with 'failurePolicy: Fail')", Data: evaluated},
+		},
+	}, nil
+}
@@ -0,0 +1,164 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ detek.Detector = &WorkloadOnSingleTopology{}
+
+type WorkloadOnSingleTopology struct{}
+
+// GetMeta implements detek.Detector
+func (*WorkloadOnSingleTopology) GetMeta() detek.DetectorInfo {
+	solution := "Spread pods of those workloads with topologySpreadConstraints (or pod anti-affinity) on 'kubernetes.io/hostname' and 'topology.kubernetes.io/zone'. " +
+		"Pods are not rescheduled by themselves, so restart the workload after that (`kubectl rollout restart`)."
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "workload_on_single_topology",
+			Description: "Finding multi-replica workloads whose running pods are all on a single node or a single zone",
+			Labels:      []string{"kubernetes", "workload", "availability", "topology"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sCoreV1PodList:         {Type: detek.TypeOf(v1.PodList{})},
+			collector.KeyK8sCoreV1NodeList:        {Type: detek.TypeOf(v1.NodeList{})},
+			collector.KeyK8sAppsV1DeploymentList:  {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList: {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+		},
+		Level: detek.Error,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads are running multiple replicas, but all of their pods are on a single node. The workload will be down if the node dies.",
+			Solution:    solution,
+		},
+		LevelDescription: detek.SeverityLevelDescription{
+			Warn: &detek.Description{
+				Explanation: "Some of workloads are running multiple replicas, but all of their pods are in a single zone. The workload will be down if the zone fails.",
+				Solution:    solution,
+			},
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*WorkloadOnSingleTopology) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	podList, err := detek.Typing[v1.PodList](
+		ctx.Get(collector.KeyK8sCoreV1PodList, nil))
+	if err != nil {
+		return nil, err
+	}
+	nodeList, err := detek.Typing[v1.NodeList](
+		ctx.Get(collector.KeyK8sCoreV1NodeList, nil))
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := loadScaleTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	zoneOf := map[string]string{}
+	zones := map[string]bool{}
+	schedulableNodes := 0
+	for _, no := range nodeList.Items {
+		if !no.Spec.Unschedulable {
+			schedulableNodes++
+		}
+		if zone, ok := no.Labels[v1.LabelTopologyZone]; ok {
+			zoneOf[no.Name] = zone
+			zones[zone] = true
+		}
+	}
+
+	type placement struct {
+		nodes, zones map[string]bool
+		pods         int
+	}
+	placements := map[string]*placement{}
+	for _, po := range podList.Items {
+		if po.Status.Phase != v1.PodRunning || po.Spec.NodeName == "" {
+			continue
+		}
+		key := po.Namespace + "/" + workloadOf(po)
+		if _, ok := workloads[key]; !ok {
+			continue
+		}
+		p, ok := placements[key]
+		if !ok {
+			p = &placement{nodes: map[string]bool{}, zones: map[string]bool{}}
+			placements[key] = p
+		}
+		p.pods++
+		p.nodes[po.Spec.NodeName] = true
+		if zone, ok := zoneOf[po.Spec.NodeName]; ok {
+			p.zones[zone] = true
+		}
+	}
+
+	type Problem struct {
+		Namespace   string
+		Workload    string
+		Replicas    int32
+		RunningPods int
+		Node        string `json:",omitempty"`
+		Zone        string `json:",omitempty"`
+		Level       detek.SeverityLevel
+	}
+	problems := []Problem{}
+	observed := detek.Normal
+
+	keys := []string{}
+	for key := range placements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		w, p := workloads[key], placements[key]
+		if replicasOf(w.Replicas) < 2 || p.pods < 2 {
+			continue
+		}
+		problem := Problem{
+			Namespace:   w.Object.Namespace,
+			Workload:    w.Kind + "/" + w.Name,
+			Replicas:    replicasOf(w.Replicas),
+			RunningPods: p.pods,
+		}
+		switch {
+		case schedulableNodes > 1 && len(p.nodes) == 1:
+			// nodes are evaluated only if pods can be spread over multiple nodes
+			problem.Node, problem.Level = firstKeyOf(p.nodes), detek.Error
+		case len(zones) > 1 && len(p.zones) == 1:
+			// zones are evaluated only if the cluster has multiple zones
+			problem.Zone, problem.Level = firstKeyOf(p.zones), detek.Warn
+		default:
+			continue
+		}
+		if problem.Level.ToInt() > observed.ToInt() {
+			observed = problem.Level
+		}
+		problems = append(problems, problem)
+	}
+
+	return &detek.ReportSpec{
+		HasPassed:     len(problems) == 0,
+		ObservedLevel: observed,
+		Problem: detek.JSONableData{
+			Description: "Workloads whose pods are on a single node or zone",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated workloads (with running pods)", Data: len(placements)},
+			{Description: "# of schedulable nodes in the cluster", Data: schedulableNodes},
+			{Description: "# of zones in the cluster", Data: len(zones)},
+		},
+	}, nil
+}
+
+func firstKeyOf(m map[string]bool) string {
+	for k := range m {
+		return k
+	}
+	return ""
+}
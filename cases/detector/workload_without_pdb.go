@@ -0,0 +1,96 @@
+package detector
+
+import (
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+var _ detek.Detector = &WorkloadWithoutPDB{}
+
+type WorkloadWithoutPDB struct{}
+
+// GetMeta implements detek.Detector
+func (*WorkloadWithoutPDB) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "workload_without_pdb",
+			Description: "Finding multi-replica Deployments and StatefulSets without any PodDisruptionBudget",
+			Labels:      []string{"kubernetes", "pdb", "workload", "availability"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAppsV1DeploymentList:            {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList:           {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+			collector.KeyK8sPolicyV1PodDisruptionBudgetList: {Type: detek.TypeOf(policyv1.PodDisruptionBudgetList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads running multiple replicas are not protected by PodDisruptionBudgets. Every replica may be evicted at once while draining nodes.",
+			Solution: "Add a PodDisruptionBudget for those workloads. " +
+				"For more information, please refer https://kubernetes.io/docs/tasks/run-application/configure-pdb/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (*WorkloadWithoutPDB) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	deploymentList, err := detek.Typing[appsv1.DeploymentList](
+		ctx.Get(collector.KeyK8sAppsV1DeploymentList, nil))
+	if err != nil {
+		return nil, err
+	}
+	statefulSetList, err := detek.Typing[appsv1.StatefulSetList](
+		ctx.Get(collector.KeyK8sAppsV1StatefulSetList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pdbList, err := detek.Typing[policyv1.PodDisruptionBudgetList](
+		ctx.Get(collector.KeyK8sPolicyV1PodDisruptionBudgetList, nil))
+	if err != nil {
+		return nil, err
+	}
+	pdbs := pdbSelectorsOf(pdbList)
+	isProtected := func(namespace string, podLabels map[string]string) bool {
+		for _, pdb := range pdbs {
+			if pdb.matches(namespace, podLabels) {
+				return true
+			}
+		}
+		return false
+	}
+
+	type Problem struct {
+		Namespace string
+		Kind      string
+		Name      string
+		Replicas  int32
+	}
+	problems := []Problem{}
+
+	for _, deploy := range deploymentList.Items {
+		replicas := replicasOf(deploy.Spec.Replicas)
+		if replicas > 1 && !isProtected(deploy.Namespace, deploy.Spec.Template.Labels) {
+			problems = append(problems, Problem{deploy.Namespace, "Deployment", deploy.Name, replicas})
+		}
+	}
+	for _, sts := range statefulSetList.Items {
+		replicas := replicasOf(sts.Spec.Replicas)
+		if replicas > 1 && !isProtected(sts.Namespace, sts.Spec.Template.Labels) {
+			problems = append(problems, Problem{sts.Namespace, "StatefulSet", sts.Name, replicas})
+		}
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Multi-replica workloads without PodDisruptionBudgets",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated Deployments", Data: len(deploymentList.Items)},
+			{Description: "# of evaluated StatefulSets", Data: len(statefulSetList.Items)},
+			{Description: "# of PodDisruptionBudgets", Data: len(pdbList.Items)},
+		},
+	}, nil
+}
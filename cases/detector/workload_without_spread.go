@@ -0,0 +1,97 @@
+package detector
+
+import (
+	"sort"
+
+	"github.com/kakao/detek/cases/collector"
+	"github.com/kakao/detek/pkg/detek"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+var _ detek.Detector = &WorkloadWithoutSpread{}
+
+type WorkloadWithoutSpread struct {
+	// Namespaces not to be evaluated. (e.g, "kube-system")
+	ExcludedNamespaces []string
+}
+
+// GetMeta implements detek.Detector
+func (*WorkloadWithoutSpread) GetMeta() detek.DetectorInfo {
+	return detek.DetectorInfo{
+		MetaInfo: detek.MetaInfo{
+			ID:          "workload_without_spread",
+			Description: "Finding multi-replica workloads without pod anti-affinity or topologySpreadConstraints",
+			Labels:      []string{"kubernetes", "workload", "availability", "topology"},
+		},
+		Required: detek.DependencyMeta{
+			collector.KeyK8sAppsV1DeploymentList:  {Type: detek.TypeOf(appsv1.DeploymentList{})},
+			collector.KeyK8sAppsV1StatefulSetList: {Type: detek.TypeOf(appsv1.StatefulSetList{})},
+		},
+		Level: detek.Warn,
+		IfHappened: detek.Description{
+			Explanation: "Some of workloads run multiple replicas, but nothing prevents the scheduler from placing them on the same node (or zone). " +
+				"A single node failure may take down every replica.",
+			Solution: "Add topologySpreadConstraints (e.g, on 'kubernetes.io/hostname' and 'topology.kubernetes.io/zone' with 'whenUnsatisfiable: ScheduleAnyway'), " +
+				"or pod anti-affinity to those workloads. " +
+				"For more information, please refer https://kubernetes.io/docs/concepts/scheduling-eviction/topology-spread-constraints/",
+		},
+	}
+}
+
+// Do implements detek.Detector
+func (d *WorkloadWithoutSpread) Do(ctx detek.DetekContext) (*detek.ReportSpec, error) {
+	workloads, err := loadScaleTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	excluded := make(map[string]bool)
+	for _, ns := range d.ExcludedNamespaces {
+		excluded[ns] = true
+	}
+
+	type Problem struct {
+		Namespace string
+		Kind      string
+		Name      string
+		Replicas  int32
+	}
+	problems := []Problem{}
+
+	keys := []string{}
+	for key := range workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		w := workloads[key]
+		if excluded[w.Object.Namespace] || replicasOf(w.Replicas) < 2 {
+			continue
+		}
+		spec := w.Template.Spec
+		if len(spec.TopologySpreadConstraints) != 0 {
+			continue
+		}
+		if a := spec.Affinity; a != nil && a.PodAntiAffinity != nil &&
+			(len(a.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution) != 0 ||
+				len(a.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) != 0) {
+			continue
+		}
+		problems = append(problems, Problem{
+			Namespace: w.Object.Namespace,
+			Kind:      w.Kind,
+			Name:      w.Name,
+			Replicas:  replicasOf(w.Replicas),
+		})
+	}
+
+	return &detek.ReportSpec{
+		HasPassed: len(problems) == 0,
+		Problem: detek.JSONableData{
+			Description: "Multi-replica workloads without pod anti-affinity or topologySpreadConstraints",
+			Data:        problems,
+		},
+		Attachment: []detek.JSONableData{
+			{Description: "# of evaluated workloads", Data: len(workloads)},
+		},
+	}, nil
+}
@@ -1,6 +1,8 @@
 package cases
 
 import (
+	"time"
+
 	"github.com/kakao/detek/cases/detector"
 	"github.com/kakao/detek/pkg/detek"
 )
@@ -12,6 +14,11 @@ var (
 		DefaultSet: func(m map[string]string) []detek.Detector {
 			return []detek.Detector{
 				&detector.FailedPod{},
+				&detector.CrashLoopingPod{},
+				&detector.FrequentlyRestartingPod{MinRestartCount: 5, Window: time.Hour},
+				&detector.OOMKilledPod{},
+				&detector.ImagePullFailedPod{},
+				&detector.PendingPod{MaxPendingDuration: 10 * time.Minute},
 				&detector.PodWithoutLimits{
 					DoNotCheckCPU:    true, // Disable Checking CPU Limits
 					DoNotChekcMemory: false,
@@ -22,9 +29,74 @@ var (
 				},
 				&detector.PodWithoutLivenessProbe{},
 				&detector.PodWithoutReadinessProbe{},
-				&detector.ServiceNoAvailableTarget{},
+				&detector.NamespaceWithoutResourceGovernance{ExcludedNamespaces: []string{"kube-system", "kube-public", "kube-node-lease"}},
+				&detector.ResourceQuotaNearExhaustion{UsageRatio: 0.9},
+				&detector.TerminatingNamespace{MaxTerminatingDuration: 10 * time.Minute},
+				&detector.ServiceNoAvailableTarget{
+					CriticalNamespaces:   []string{"kube-system"},
+					DevNamespacePatterns: []string{"dev", "dev-*", "*-dev"},
+				},
 				&detector.ServicePartiallyAvailable{},
-				&detector.ApiLifecyclePolicyV1Beta1{},
+				&detector.ServiceSelectorMatchesNoPod{},
+				&detector.ServiceTargetPortMismatch{},
+				&detector.ServiceSelectingMultipleWorkloads{},
+				&detector.LoadBalancerPending{MaxPendingDuration: 10 * time.Minute},
+				&detector.IngressBackendMissing{},
+				&detector.IngressTLSSecretMissing{},
+				&detector.TLSCertificateExpiring{WarnBefore: 30 * 24 * time.Hour, ErrorBefore: 7 * 24 * time.Hour},
+				&detector.TLSCertificateHostMismatch{},
+				&detector.PendingPVC{MaxPendingDuration: 10 * time.Minute},
+				&detector.UnusedPersistentVolume{},
+				&detector.DefaultStorageClass{},
+				&detector.PodWithMissingPVC{},
+				&detector.StuckVolumeAttachment{MaxDetachingDuration: 10 * time.Minute},
+				&detector.WebhookServiceUnavailable{},
+				&detector.WebhookWithoutKubeSystemExclusion{},
+				&detector.APIServiceUnavailable{},
+				&detector.DeprecatedAPIInUse{TargetVersion: m[CONFIG_TARGET_VERSION]},
+				&detector.PodSecurityStandards{},
+				&detector.ServiceAccountClusterAdmin{ExcludedNamespaces: []string{"kube-system"}},
+				&detector.RBACWildcardRule{},
+				&detector.RBACEscalationCapable{ExcludedNamespaces: []string{"kube-system"}},
+				&detector.RBACBindingMissingSubject{},
+				&detector.DefaultServiceAccountAutomount{ExcludedNamespaces: []string{"kube-system"}},
+				&detector.NetworkPolicyCoverage{ExcludedNamespaces: []string{"kube-system"}},
+				&detector.CronJobWithoutRecentSuccess{MissedSchedules: 2},
+				&detector.SuspendedCronJob{},
+				&detector.FailedJob{},
+				&detector.JobDeadlineExceeded{},
+				&detector.FinishedJobWithoutTTL{MinFinishedJobs: 10},
+				&detector.HPAAtMaxReplicas{},
+				&detector.HPAUnableToScale{},
+				&detector.HPATargetMissing{},
+				&detector.HPATargetWithoutRequests{},
+				&detector.HPAReplicasConflict{},
+				&detector.SingleReplicaWorkload{ServiceBackedOnly: true},
+				&detector.WorkloadOnSingleTopology{},
+				&detector.WorkloadWithoutSpread{ExcludedNamespaces: []string{"kube-system"}},
+				&detector.WorkloadWithoutPDB{},
+				&detector.PDBBlockingDrain{},
+				&detector.PDBWithoutPods{},
+				&detector.OverlappingPDB{},
+				&detector.NotReadyNode{},
+				&detector.NodeUnderPressure{},
+				&detector.LongCordonedNode{MaxCordonedDuration: 24 * time.Hour},
+				&detector.NodeResourceCommitment{LimitOvercommitFactor: 1.5},
+				&detector.CPUHotNode{UsageRatio: 0.9},
+				&detector.OverRequestedWorkload{MinUsageRatio: 0.2},
+				&detector.ContainerNearMemoryLimit{UsageRatio: 0.9},
+				&detector.KubeletVersionSkew{MaxMinorSkew: 2},
+			}
+		},
+		UpgradeSet: func(m map[string]string) []detek.Detector {
+			return []detek.Detector{
+				&detector.ControlPlaneUpgradePath{TargetVersion: m[CONFIG_TARGET_VERSION]},
+				&detector.DeprecatedAPIInUse{TargetVersion: m[CONFIG_TARGET_VERSION]},
+				&detector.DeprecatedPodSpecField{TargetVersion: m[CONFIG_TARGET_VERSION]},
+				&detector.KubeletVersionSkew{MaxMinorSkew: 2, TargetVersion: m[CONFIG_TARGET_VERSION]},
+				&detector.PDBBlockingDrain{},
+				&detector.OverlappingPDB{},
+				&detector.SingleReplicaWorkload{},
 			}
 		},
 		// add more preset here
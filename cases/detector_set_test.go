@@ -10,16 +10,22 @@ import (
 )
 
 func TestValidatingDetectorMeta(t *testing.T) {
-	IDMap := make(map[string]bool)
-	for _, set := range cases.DetectorSet {
+	for name, set := range cases.DetectorSet {
+		// IDs should be unique in a set
+		IDMap := make(map[string]bool)
 		for _, d := range set(map[string]string{}) {
 			meta := d.GetMeta()
 			assert.NotEmpty(t, meta.ID, fmt.Sprintf("id for %q is not set", detek.TypeOf(d).String()))
 			assert.NotEmpty(t, meta.Description, fmt.Sprintf("description for %q is not set", meta.ID))
 			assert.NotEmpty(t, meta.IfHappened.Explanation, fmt.Sprintf("explanation for %q is not set", meta.ID))
 			assert.NotEmpty(t, meta.Level, fmt.Sprintf("level for %q is not set", meta.ID))
+			for _, level := range []detek.SeverityLevel{detek.Fatal, detek.Error, detek.Warn, detek.Normal} {
+				if desc := meta.LevelDescription.Get(level); desc != nil {
+					assert.NotEmpty(t, desc.Explanation, fmt.Sprintf("explanation of %s level for %q is not set", level, meta.ID))
+				}
+			}
 			if _, ok := IDMap[meta.ID]; ok {
-				assert.Fail(t, "duplicated ID detected", meta.ID)
+				assert.Fail(t, "duplicated ID detected", "%s in %q set", meta.ID, name)
 			}
 			IDMap[meta.ID] = true
 		}
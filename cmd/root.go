@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kakao/detek/pkg/detek"
 	"github.com/kakao/detek/pkg/log"
 	"github.com/kakao/detek/pkg/renderer"
 	"github.com/spf13/cobra"
@@ -14,9 +15,10 @@ var (
 )
 
 var rootCmd = &cobra.Command{
-	Use:   "detek",
-	Short: "Detecting Kubernetes known issues",
-	Long:  `Detect is a cluster diagnostic tool, which aims to detect known issues automatically.`,
+	Use:     "detek",
+	Short:   "Detecting Kubernetes known issues",
+	Long:    `Detect is a cluster diagnostic tool, which aims to detect known issues automatically.`,
+	Version: detek.Version,
 }
 
 func Execute() {
@@ -3,8 +3,10 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/kakao/detek/cases"
+	"github.com/kakao/detek/cases/detector"
 	"github.com/kakao/detek/pkg/detek"
 	"github.com/kakao/detek/pkg/renderer"
 	"github.com/kakao/detek/pkg/utils"
@@ -13,9 +15,11 @@ import (
 
 var (
 	kubeconfigPath string
+	targetVersion  string
 	outputFormstS  string
 	outputFormat   renderer.Format
 	renderOpts     renderer.RenderOpts
+	outputsS       []string
 )
 
 var runCmd = &cobra.Command{
@@ -35,12 +39,18 @@ detek run kakao
 //   1. kubeconfig file located by "--kubeconfig" flag
 //   2. kubeconfig file located by "KUBECONFIG" env
 //   3. in-cluster client configuration (useful when using detek in a kubernetes cluster)
-//   4. kubeconfig file located in default directory ($HOME/.kube/config)`,
+//   4. kubeconfig file located in default directory ($HOME/.kube/config)
+
+// find apis which are deprecated or removed in the version to upgrade to
+detek run --target-version 1.27
+
+// render the reports into several formats in a single run
+detek run --output html=report.html --output json=report.json --output table=-`,
 		utils.Keys(cases.DetectorSet)),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		{
+		if targetVersion != "" {
 			// pre-validation
-			if err := outputFormat.IsValid(); err != nil {
+			if _, err := detector.ParseKubernetesVersion(targetVersion); err != nil {
 				return err
 			}
 		}
@@ -48,29 +58,65 @@ detek run kakao
 		if len(args) != 0 {
 			targetSet = args[0]
 		}
-		m := detek.NewManager(
-			cases.CollectorSet[targetSet](map[string]string{
+		return runSet(targetSet,
+			map[string]string{
 				cases.CONFIG_KUBECONFIG: kubeconfigPath,
-			}),
-			cases.DetectorSet[targetSet](map[string]string{}),
+			},
+			map[string]string{
+				cases.CONFIG_TARGET_VERSION: targetVersion,
+			},
 		)
-		list, err := m.Run(context.Background(), &detek.MangerRunOptions{})
+	},
+	SilenceUsage: true,
+}
+
+// runSet runs a given case set, and writes reports to outputs set by flags.
+func runSet(targetSet string, collectorConfig, detectorConfig map[string]string) error {
+	{
+		// pre-validation
+		if err := outputFormat.IsValid(); err != nil {
+			return err
+		}
+		if _, ok := cases.DetectorSet[targetSet]; !ok {
+			return fmt.Errorf("unknown test set %q, available test sets are %v", targetSet, utils.Keys(cases.DetectorSet))
+		}
+	}
+	outputs := []renderer.Output{}
+	for _, o := range outputsS {
+		output, err := renderer.ParseOutput(o)
 		if err != nil {
 			return err
 		}
-		fmt.Println(
-			renderer.RenderReports(list, outputFormat, renderOpts),
-		)
-		return nil
-	},
-	SilenceUsage: true,
+		outputs = append(outputs, output)
+	}
+	if len(outputs) == 0 {
+		outputs = append(outputs, renderer.Output{Format: outputFormat, Path: renderer.StdoutPath})
+	}
+	m := detek.NewManager(
+		cases.CollectorSet[targetSet](collectorConfig),
+		cases.DetectorSet[targetSet](detectorConfig),
+	)
+	list, err := m.Run(context.Background(), &detek.MangerRunOptions{})
+	if err != nil {
+		return err
+	}
+	return renderer.WriteReports(list, outputs, renderOpts, os.Stdout)
+}
+
+// addOutputFlags adds flags deciding how to render reports.
+func addOutputFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVarP(&outputFormstS, "format", "f", "html", "set output format. [json|yaml|table|html|markdown] ")
+	cmd.PersistentFlags().StringArrayVarP(&outputsS, "output", "o", nil, "write reports to files as <format>=<path>, can be repeated. \"-\" as a path means stdout (overrides --format)")
+	cmd.PersistentFlags().IntVar(&renderOpts.Table.MaxWidth, "table-max-width", 0, "truncate overflowed contents in table")
+	cmd.PersistentFlags().BoolVar(&renderOpts.JSON.Pretty, "json-pretty", true, "prettify json output")
+	cmd.PersistentFlags().IntVar(&renderOpts.Markdown.MaxItems, "markdown-max-items", 50, "maximum number of problem items per report in markdown (0 for unlimited)")
+	cmd.PersistentFlags().IntVar(&renderOpts.Markdown.MaxCellWidth, "markdown-max-cell-width", 120, "truncate overflowed contents in markdown table cells (0 for unlimited)")
 }
 
 func init() {
 	flags := runCmd.Flags()
 	flags.StringVar(&kubeconfigPath, "kubeconfig", "", "set kubeconfig path")
+	flags.StringVar(&targetVersion, "target-version", "", "kubernetes version to upgrade to (e.g, 1.27), used to find apis deprecated or removed in that version")
 	rootCmd.AddCommand(runCmd)
-	runCmd.PersistentFlags().StringVarP(&outputFormstS, "format", "f", "html", "set output format. [json|table|html] ")
-	runCmd.PersistentFlags().IntVar(&renderOpts.Table.MaxWidth, "table-max-width", 0, "truncate overflowed contents in table")
-	runCmd.PersistentFlags().BoolVar(&renderOpts.JSON.Pretty, "json-pretty", true, "prettify json output")
+	addOutputFlags(runCmd)
 }
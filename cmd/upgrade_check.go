@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/kakao/detek/cases"
+	"github.com/kakao/detek/cases/detector"
+	"github.com/spf13/cobra"
+)
+
+var upgradeTargetVersion string
+
+var upgradeCheckCmd = &cobra.Command{
+	Use:   "upgrade-check",
+	Short: "check whether the Kubernetes cluster is ready to be upgraded to a target version",
+	Long: `check whether the Kubernetes cluster is ready to be upgraded to a target version
+// this will run "upgrade" test set, which checks
+//   - apis removed (or deprecated) in the target version, but still in use
+//   - deprecated fields and annotations in pod specs
+//   - kubelet / control plane version skew against the target version
+//   - PodDisruptionBudgets which will block node drains
+//   - single replica workloads, which will have downtime during node drains
+detek upgrade-check --to 1.27
+
+// render the reports into several formats in a single run
+detek upgrade-check --to 1.27 --output markdown=upgrade-1.27.md --output table=-`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// pre-validation
+		if _, err := detector.ParseKubernetesVersion(upgradeTargetVersion); err != nil {
+			return err
+		}
+		return runSet(cases.UpgradeSet,
+			map[string]string{
+				cases.CONFIG_KUBECONFIG: kubeconfigPath,
+			},
+			map[string]string{
+				cases.CONFIG_TARGET_VERSION: upgradeTargetVersion,
+			},
+		)
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	flags := upgradeCheckCmd.Flags()
+	flags.StringVar(&kubeconfigPath, "kubeconfig", "", "set kubeconfig path")
+	flags.StringVar(&upgradeTargetVersion, "to", "", "kubernetes version to upgrade to (e.g, 1.27)")
+	_ = upgradeCheckCmd.MarkFlagRequired("to")
+	rootCmd.AddCommand(upgradeCheckCmd)
+	addOutputFlags(upgradeCheckCmd)
+}
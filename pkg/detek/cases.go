@@ -27,6 +27,31 @@ type DetectorInfo struct {
 
 	// Show what user can do when the thing has happened.
 	IfHappened Description `json:"-"`
+
+	// (Optional) Declare the other levels which this case may report with "ObservedLevel" in ReportSpec.
+	// "Level" and "IfHappened" are always treated as declared.
+	LevelDescription SeverityLevelDescription `json:"-"`
+}
+
+// DescriptionOf returns the description of a given level, and whether the level is declared or not.
+func (d *DetectorInfo) DescriptionOf(level SeverityLevel) (Description, bool) {
+	if desc := d.LevelDescription.Get(level); desc != nil {
+		return *desc, true
+	}
+	if level == d.Level {
+		return d.IfHappened, true
+	}
+	return Description{}, false
+}
+
+// LevelDescriptions returns every declared level with its description.
+func (d *DetectorInfo) LevelDescriptions() SeverityLevelDescription {
+	result := d.LevelDescription
+	if result.Get(d.Level) == nil {
+		ifHappened := d.IfHappened
+		result.Set(d.Level, &ifHappened)
+	}
+	return result
 }
 
 type Description struct {
@@ -68,6 +93,35 @@ type SeverityLevelDescription struct {
 	Normal *Description `json:"normal,omitempty"`
 }
 
+// Get returns the description of a given level. (nil if not declared)
+func (s *SeverityLevelDescription) Get(level SeverityLevel) *Description {
+	switch level {
+	case Fatal:
+		return s.Fatal
+	case Error:
+		return s.Error
+	case Warn:
+		return s.Warn
+	case Normal:
+		return s.Normal
+	}
+	return nil
+}
+
+// Set sets the description of a given level.
+func (s *SeverityLevelDescription) Set(level SeverityLevel, desc *Description) {
+	switch level {
+	case Fatal:
+		s.Fatal = desc
+	case Error:
+		s.Error = desc
+	case Warn:
+		s.Warn = desc
+	case Normal:
+		s.Normal = desc
+	}
+}
+
 // Collector Definitions
 type CollectorInfo struct {
 	MetaInfo
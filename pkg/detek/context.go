@@ -76,7 +76,11 @@ func (c *DetekContext) Get(key string, v any) (*Stored, error) {
 	// fetch value from store
 	val, stored, err := c.store.Get(key)
 	if err != nil {
-		log.Error(c.ctx, "store error:[%s] no requested key in store", key)
+		if plan, ok := c.opt.ConsumingPlan[key]; ok && plan.IsOptional {
+			log.Info(c.ctx, "store: optional key [%s] is not provided", key)
+		} else {
+			log.Error(c.ctx, "store error:[%s] no requested key in store", key)
+		}
 		return nil, errors.Wrapf(err, "fail to get %q", key)
 	}
 	// if i == nil, return directly
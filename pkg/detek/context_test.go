@@ -130,10 +130,10 @@ func TestDetekContext_Set(t *testing.T) {
 			opt: detekConfigOpts{
 				Meta: MetaInfo{ID: "test"},
 				ProducingPlan: DependencyMeta{
-					STRING_KEY: DependencyInfo{TypeOf(STRING_DATA)},
+					STRING_KEY: DependencyInfo{Type: TypeOf(STRING_DATA)},
 				},
 				ConsumingPlan: DependencyMeta{
-					STRING_KEY: DependencyInfo{TypeOf(STRING_DATA)},
+					STRING_KEY: DependencyInfo{Type: TypeOf(STRING_DATA)},
 				},
 			},
 			store: &Store{kv: make(map[string]Stored), mu: sync.RWMutex{}},
@@ -4,9 +4,11 @@ import "reflect"
 
 type DependencyInfo struct {
 	Type reflect.Type
+	// if true, the collector (or detector) will be run even if the data is not provided.
+	// (in that case, DetekContext.Get returns an error which IsKeyNotFound)
+	IsOptional bool
 	// TODO
 	// Description string
-	// IsOptional  bool
 }
 
 func TypeOf(v interface{}) reflect.Type {
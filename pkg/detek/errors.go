@@ -1,6 +1,9 @@
 package detek
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 func NewError(cause error, reason ErrorType) error {
 	return &DetekError{cause: cause, reason: reason}
@@ -29,3 +32,10 @@ func (d *DetekError) Error() string {
 func (d *DetekError) Unwrap() error {
 	return d.cause
 }
+
+// IsKeyNotFound returns whether the error is caused by a key not found in the store.
+// (e.g, optional data which is not provided)
+func IsKeyNotFound(err error) bool {
+	var d *DetekError
+	return errors.As(err, &d) && d.reason == ErrKeyNotFound
+}
@@ -10,6 +10,7 @@ type FD struct {
 	Value         interface{}
 	ShouldProduce bool
 	ShouldConsume bool
+	IsOptional    bool
 }
 
 type FakeCollector struct {
@@ -23,7 +24,7 @@ type FakeCollector struct {
 func (i FakeCollector) GetMeta() CollectorInfo {
 	Required := make(DependencyMeta)
 	for _, d := range i.Required {
-		Required[d.Key] = DependencyInfo{Type: TypeOf(d.Value)}
+		Required[d.Key] = DependencyInfo{Type: TypeOf(d.Value), IsOptional: d.IsOptional}
 	}
 	Producing := make(DependencyMeta)
 	for _, d := range i.Producing {
@@ -71,12 +72,15 @@ type FakeDetector struct {
 	IsError     bool
 	IsPanic     bool
 	ShoudPassed bool
+
+	ObservedLevel    SeverityLevel
+	LevelDescription SeverityLevelDescription
 }
 
 func (i FakeDetector) GetMeta() DetectorInfo {
 	Required := make(DependencyMeta)
 	for _, d := range i.Required {
-		Required[d.Key] = DependencyInfo{Type: TypeOf(d.Value)}
+		Required[d.Key] = DependencyInfo{Type: TypeOf(d.Value), IsOptional: d.IsOptional}
 	}
 	return DetectorInfo{
 		MetaInfo: MetaInfo{ID: i.Name},
@@ -86,6 +90,7 @@ func (i FakeDetector) GetMeta() DetectorInfo {
 			Explanation: "Intended Failure",
 			Solution:    "Detect this properly",
 		},
+		LevelDescription: i.LevelDescription,
 	}
 }
 func (i FakeDetector) Do(ctx DetekContext) (*ReportSpec, error) {
@@ -98,7 +103,9 @@ func (i FakeDetector) Do(ctx DetekContext) (*ReportSpec, error) {
 	for _, r := range i.Required {
 		if r.ShouldConsume {
 			val, err := ctx.Get(r.Key, nil)
-			if err != nil {
+			if r.IsOptional && IsKeyNotFound(err) {
+				continue
+			} else if err != nil {
 				return nil, err
 			}
 			if val.Type.Kind() != TypeOf(r.Value).Kind() {
@@ -107,6 +114,7 @@ func (i FakeDetector) Do(ctx DetekContext) (*ReportSpec, error) {
 		}
 	}
 	return &ReportSpec{
-		HasPassed: i.ShoudPassed,
+		HasPassed:     i.ShoudPassed,
+		ObservedLevel: i.ObservedLevel,
 	}, nil
 }
@@ -71,6 +71,11 @@ func (m *Manager) Run(ctx context.Context, opts *MangerRunOptions) (*ReportList,
 			var val interface{}
 			val, _, err = m.store.Get(k)
 			if err != nil {
+				if v.IsOptional && IsKeyNotFound(err) {
+					// optional data is not provided
+					err = nil
+					continue
+				}
 				err = errors.Wrap(err, k)
 				break
 			} else if v.Type.Kind() != TypeOf(val).Kind() {
@@ -106,6 +111,11 @@ func (m *Manager) Run(ctx context.Context, opts *MangerRunOptions) (*ReportList,
 		}
 	}
 	log.Info(ctx, "All Collector are doing there jobs well")
+	if v, _, err := m.store.Get(KeyClusterInfo); err == nil {
+		if info, ok := v.(ClusterInfo); ok {
+			result.Cluster = &info
+		}
+	}
 	collectingReport := Report{
 		CreatedAt: time.Now(),
 		MetaInfo: MetaInfo{
@@ -152,6 +162,11 @@ func (m *Manager) Run(ctx context.Context, opts *MangerRunOptions) (*ReportList,
 			var val interface{}
 			val, _, err = m.store.Get(k)
 			if err != nil {
+				if v.IsOptional && IsKeyNotFound(err) {
+					// optional data is not provided
+					err = nil
+					continue
+				}
 				err = errors.Wrap(err, k)
 				break
 			} else if v.Type.Kind() != TypeOf(val).Kind() {
@@ -192,6 +207,11 @@ func (m *Manager) Run(ctx context.Context, opts *MangerRunOptions) (*ReportList,
 			if report == nil && err == nil {
 				err = errors.New("No report from test")
 			}
+			if err == nil && !report.HasPassed && report.ObservedLevel != "" {
+				if _, ok := meta.DescriptionOf(report.ObservedLevel); !ok {
+					err = fmt.Errorf("detector reported %q level, which is not declared in its meta", report.ObservedLevel)
+				}
+			}
 			if err != nil {
 				report = &Report{
 					Level:        Fatal,
@@ -206,14 +226,21 @@ func (m *Manager) Run(ctx context.Context, opts *MangerRunOptions) (*ReportList,
 			} else {
 				report.Level = Normal
 				report.CurrentState = NormalStatus
+				if desc := meta.LevelDescription.Normal; desc != nil {
+					report.CurrentState = *desc
+				}
 				if !report.HasPassed {
 					report.Level = meta.Level
-					report.CurrentState = meta.IfHappened
+					if report.ObservedLevel != "" {
+						report.Level = report.ObservedLevel
+					}
+					report.CurrentState, _ = meta.DescriptionOf(report.Level)
 				}
 			}
 		}
 
 		report.MetaInfo = meta.MetaInfo
+		report.LevelDescription = meta.LevelDescriptions()
 		report.CreatedAt = time.Now()
 
 		log.Info(ctx, "%v", report)
@@ -98,6 +98,56 @@ func TestManager_Run(t *testing.T) {
 				{MetaInfo: MetaInfo{ID: "det-2"}, Level: Normal},
 			},
 		},
+		{
+			name: "Detector reports observed level",
+			fields: fields{
+				Collector: []Collector{
+					FakeCollector{
+						Name:      "col-1",
+						Required:  []FD{},
+						Producing: []FD{{Key: "typeA", Value: ValueA, ShouldProduce: true}},
+					},
+				},
+				Detector: []Detector{
+					FakeDetector{
+						Name:          "det-1",
+						Required:      []FD{{Key: "typeA", Value: ValueA, ShouldConsume: true}},
+						ShoudPassed:   false,
+						ObservedLevel: Warn,
+						LevelDescription: SeverityLevelDescription{
+							Warn: &Description{Explanation: "Intended Warning"},
+						},
+					},
+					FakeDetector{
+						Name:          "det-2",
+						Required:      []FD{{Key: "typeA", Value: ValueA, ShouldConsume: true}},
+						ShoudPassed:   true,
+						ObservedLevel: Warn,
+					},
+					FakeDetector{
+						Name:          "det-3",
+						Required:      []FD{{Key: "typeA", Value: ValueA, ShouldConsume: true}},
+						ShoudPassed:   false,
+						ObservedLevel: Error,
+					},
+					FakeDetector{
+						Name:          "det-4",
+						Required:      []FD{{Key: "typeA", Value: ValueA, ShouldConsume: true}},
+						ShoudPassed:   false,
+						ObservedLevel: Fatal,
+					},
+				},
+				store: &Store{kv: make(map[string]Stored)},
+			}, args: args{ctx: ctx},
+			want: []Report{
+				{MetaInfo: MetaInfo{ID: "det-1"}, Level: Warn},
+				{MetaInfo: MetaInfo{ID: "det-2"}, Level: Normal},
+				// "Error" is declared as a default level
+				{MetaInfo: MetaInfo{ID: "det-3"}, Level: Error},
+				// "Fatal" is not declared, will be treated as an error on detector
+				{MetaInfo: MetaInfo{ID: "det-4"}, Level: Fatal},
+			},
+		},
 		{
 			name: "One of Detectors return error",
 			fields: fields{
@@ -199,6 +249,43 @@ func TestManager_Run(t *testing.T) {
 				{MetaInfo: MetaInfo{ID: "det-2"}, Level: Unknown},
 			},
 		},
+		{
+			name: "Optional data is not provided",
+			fields: fields{
+				Collector: []Collector{
+					FakeCollector{
+						Name:      "col-1",
+						Required:  []FD{},
+						Producing: []FD{{Key: "typeA", Value: ValueA, ShouldProduce: true}},
+					},
+					FakeCollector{
+						Name:      "col-2",
+						Required:  []FD{{Key: "typeA", Value: ValueA, ShouldConsume: true}},
+						Producing: []FD{{Key: "typeB", Value: ValueB, ShouldProduce: false}},
+					},
+				},
+				Detector: []Detector{
+					FakeDetector{
+						Name: "det-1",
+						Required: []FD{
+							{Key: "typeA", Value: ValueA, ShouldConsume: true},
+							{Key: "typeB", Value: ValueB, ShouldConsume: true, IsOptional: true},
+						},
+						ShoudPassed: true,
+					},
+					FakeDetector{
+						Name:        "det-2",
+						Required:    []FD{{Key: "typeB", Value: ValueB, ShouldConsume: true, IsOptional: true}},
+						ShoudPassed: false,
+					},
+				},
+				store: &Store{kv: make(map[string]Stored)},
+			}, args: args{ctx: ctx},
+			want: []Report{
+				{MetaInfo: MetaInfo{ID: "det-1"}, Level: Normal},
+				{MetaInfo: MetaInfo{ID: "det-2"}, Level: Error},
+			},
+		},
 		{
 			name: "Panic on Collector",
 			fields: fields{
@@ -65,13 +65,19 @@ type Report struct {
 	CreatedAt time.Time     `json:"created_at"`
 	Level     SeverityLevel `json:"level"`
 
-	CurrentState Description `json:"-"`
+	CurrentState Description `json:"current_state"`
+	// Every level declared by the Detector, with its description.
+	LevelDescription SeverityLevelDescription `json:"level_description"`
 	ReportSpec
 }
 
 type ReportSpec struct {
 	// Is this Passed?
-	HasPassed bool `json:"-"`
+	HasPassed bool `json:"has_passed"`
+
+	// Severity observed in this run (optional).
+	// If not set, "Level" in DetectorInfo will be used.
+	ObservedLevel SeverityLevel `json:"-"`
 
 	// Attachment to show the causes of problem.
 	Problem JSONableData `json:"problem,omitempty"`
@@ -80,9 +86,22 @@ type ReportSpec struct {
 	Attachment []JSONableData `json:"attachment,omitempty"`
 }
 
+// KeyClusterInfo is a well-known key of the Store.
+// If one of Collectors produces ClusterInfo with this key, it will be attached to the ReportList.
+const KeyClusterInfo = "detek_cluster_info"
+
+// ClusterInfo shows which cluster the reports are generated from.
+type ClusterInfo struct {
+	Name    string `json:"name,omitempty"`
+	Server  string `json:"server,omitempty"`
+	UID     string `json:"uid,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
 type ReportList struct {
-	StartedAt  time.Time `json:"started_at"`
-	FinishedAt time.Time `json:"finished_at"`
+	StartedAt  time.Time    `json:"started_at"`
+	FinishedAt time.Time    `json:"finished_at"`
+	Cluster    *ClusterInfo `json:"cluster,omitempty"`
 
 	Reports []Report `json:"reports"`
 }
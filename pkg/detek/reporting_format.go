@@ -1,6 +1,33 @@
 package detek
 
-import "time"
+import (
+	_ "embed"
+	"time"
+)
+
+const (
+	// ExportingAPIVersion is a version of the exporting schema.
+	// It should be bumped when a backward incompatible change is made in ReportListExportingFormat.
+	ExportingAPIVersion = "detek.kakao.com/v1alpha1"
+	ExportingKind       = "ReportList"
+)
+
+// ExportingSchema is a JSON Schema of ReportListExportingFormat.
+//
+//go:embed schema/reportlist.v1alpha1.schema.json
+var ExportingSchema string
+
+// ReportListExportingFormat is a stable, machine-readable format of ReportList.
+type ReportListExportingFormat struct {
+	APIVersion   string       `json:"apiVersion"`
+	Kind         string       `json:"kind"`
+	DetekVersion string       `json:"detek_version"`
+	Cluster      *ClusterInfo `json:"cluster,omitempty"`
+	StartedAt    time.Time    `json:"started_at"`
+	FinishedAt   time.Time    `json:"finished_at"`
+
+	Reports []ReportExportingFormat `json:"reports"`
+}
 
 type ReportExportingFormat struct {
 	ID          string    `json:"id"`
@@ -10,6 +37,7 @@ type ReportExportingFormat struct {
 
 	Level            SeverityLevel            `json:"level"`
 	LevelDescription SeverityLevelDescription `json:"level_description"`
+	HasPassed        bool                     `json:"has_passed"`
 
 	CurrentState string       `json:"current_state"`
 	Solution     string       `json:"solution"`
@@ -17,3 +45,52 @@ type ReportExportingFormat struct {
 
 	Attachments []JSONableData `json:"attachments"`
 }
+
+// Export converts ReportList to the stable exporting format.
+func (r *ReportList) Export() ReportListExportingFormat {
+	result := ReportListExportingFormat{
+		APIVersion:   ExportingAPIVersion,
+		Kind:         ExportingKind,
+		DetekVersion: Version,
+		Cluster:      r.Cluster,
+		StartedAt:    r.StartedAt,
+		FinishedAt:   r.FinishedAt,
+		Reports:      []ReportExportingFormat{},
+	}
+	for _, report := range r.Reports {
+		result.Reports = append(result.Reports, report.Export())
+	}
+	return result
+}
+
+// Export converts Report to the stable exporting format.
+func (r *Report) Export() ReportExportingFormat {
+	labels := r.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	attachments := r.Attachment
+	if attachments == nil {
+		attachments = []JSONableData{}
+	}
+
+	levelDesc := r.LevelDescription
+	if levelDesc.Get(r.Level) == nil {
+		state := r.CurrentState
+		levelDesc.Set(r.Level, &state)
+	}
+
+	return ReportExportingFormat{
+		ID:               r.ID,
+		Description:      r.Description,
+		Labels:           labels,
+		CreatedAt:        r.CreatedAt,
+		Level:            r.Level,
+		LevelDescription: levelDesc,
+		HasPassed:        r.HasPassed,
+		CurrentState:     r.CurrentState.Explanation,
+		Solution:         r.CurrentState.Solution,
+		Problem:          r.Problem,
+		Attachments:      attachments,
+	}
+}
@@ -0,0 +1,66 @@
+package detek
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportList_Export(t *testing.T) {
+	type property map[string]json.RawMessage
+	var schema struct {
+		Required   []string `json:"required"`
+		Properties property `json:"properties"`
+		Defs       map[string]struct {
+			Required   []string `json:"required"`
+			Properties property `json:"properties"`
+		} `json:"$defs"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(ExportingSchema), &schema))
+
+	list := ReportList{
+		StartedAt:  time.Now(),
+		FinishedAt: time.Now(),
+		Cluster:    &ClusterInfo{Name: "test", UID: "uid"},
+		Reports: []Report{
+			{
+				MetaInfo:     MetaInfo{ID: "det-1"},
+				Level:        Error,
+				CurrentState: Description{Explanation: "explanation", Solution: "solution"},
+				ReportSpec:   ReportSpec{Problem: JSONableData{Description: "problem", Data: []string{"a"}}},
+			},
+			{MetaInfo: MetaInfo{ID: "det-2"}, Level: Normal, CurrentState: NormalStatus},
+		},
+	}
+	exported := list.Export()
+	assert.Equal(t, ExportingAPIVersion, exported.APIVersion)
+	assert.Equal(t, ExportingKind, exported.Kind)
+	assert.Equal(t, "solution", exported.Reports[0].Solution)
+	assert.Equal(t, "explanation", exported.Reports[0].LevelDescription.Error.Explanation)
+	assert.Nil(t, exported.Reports[0].LevelDescription.Fatal)
+
+	// every field should be declared in the schema, and every required field should be exported.
+	b, err := json.Marshal(exported)
+	assert.NoError(t, err)
+	var top map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(b, &top))
+	for k := range top {
+		assert.Contains(t, schema.Properties, k)
+	}
+	for _, k := range schema.Required {
+		assert.Contains(t, top, k)
+	}
+
+	var reports []map[string]json.RawMessage
+	assert.NoError(t, json.Unmarshal(top["reports"], &reports))
+	for _, report := range reports {
+		for k := range report {
+			assert.Contains(t, schema.Defs["report"].Properties, k)
+		}
+		for _, k := range schema.Defs["report"].Required {
+			assert.Contains(t, report, k)
+		}
+	}
+}
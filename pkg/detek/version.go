@@ -0,0 +1,6 @@
+package detek
+
+// Version of detek, it is overridden at build time.
+//
+//	go build -ldflags "-X github.com/kakao/detek/pkg/detek.Version=v0.1.0"
+var Version = "dev"
@@ -11,9 +11,9 @@ func RenderJSONReports(r detek.ReportList, pretty bool) string {
 	var b []byte
 	var err error
 	if pretty {
-		b, err = json.MarshalIndent(r, "", "  ")
+		b, err = json.MarshalIndent(r.Export(), "", "  ")
 	} else {
-		b, err = json.Marshal(r)
+		b, err = json.Marshal(r.Export())
 	}
 	if err != nil {
 		panic(fmt.Errorf("this is a bug: %w", err))
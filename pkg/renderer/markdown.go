@@ -0,0 +1,211 @@
+package renderer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kakao/detek/pkg/detek"
+)
+
+// RenderMarkdownReports renders reports in GitHub flavored markdown,
+// which can be pasted into issue trackers, pull requests or chats.
+//
+// maxItems limits the number of problem items rendered per report (0 means unlimited),
+// maxCellWidth truncates long table cells (0 means unlimited).
+func RenderMarkdownReports(r detek.ReportList, maxItems int, maxCellWidth int) string {
+	levels := []detek.SeverityLevel{
+		detek.Fatal, detek.Error, detek.Warn, detek.Normal, detek.Unknown,
+	}
+	reportMap := make(map[detek.SeverityLevel][]detek.Report)
+	for _, report := range r.Reports {
+		reportMap[report.Level] = append(reportMap[report.Level], report)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# detek Cluster Report\n\n")
+	fmt.Fprintf(&buf, "- **started at:** %s\n", r.StartedAt.Format("Jan 02, 2006 15:04:05 UTC"))
+	fmt.Fprintf(&buf, "- **finished at:** %s\n\n", r.FinishedAt.Format("Jan 02, 2006 15:04:05 UTC"))
+
+	// Summary
+	buf.WriteString("## Summary\n\n")
+	buf.WriteString("| Level | Count |\n")
+	buf.WriteString("| --- | ---: |\n")
+	for _, level := range levels {
+		fmt.Fprintf(&buf, "| %s | %d |\n", level, len(reportMap[level]))
+	}
+	fmt.Fprintf(&buf, "| **Total** | **%d** |\n", len(r.Reports))
+
+	// Reports
+	for _, level := range levels {
+		reports, ok := reportMap[level]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&buf, "\n## Level: %s\n", level)
+		for _, report := range reports {
+			buf.WriteString("\n<details>\n")
+			fmt.Fprintf(&buf, "<summary><b>%s</b> - %s</summary>\n\n",
+				escapeMarkdownHTML(report.ID), escapeMarkdownHTML(report.Description))
+			if len(report.Labels) != 0 {
+				fmt.Fprintf(&buf, "**labels:** `%s`\n\n", strings.Join(report.Labels, "`, `"))
+			}
+			fmt.Fprintf(&buf, "**Current State:** %s\n\n", report.CurrentState.Explanation)
+			if report.CurrentState.Solution != "" {
+				fmt.Fprintf(&buf, "**Solution:** %s\n\n", report.CurrentState.Solution)
+			}
+			if report.Problem.Data != nil {
+				fmt.Fprintf(&buf, "#### %s\n\n", report.Problem.Description)
+				buf.WriteString(renderMarkdownData(report.Problem.Data, maxItems, maxCellWidth))
+				buf.WriteString("\n")
+			}
+			for _, attach := range report.Attachment {
+				fmt.Fprintf(&buf, "#### %s\n\n", attach.Description)
+				buf.WriteString(renderMarkdownData(attach.Data, maxItems, maxCellWidth))
+				buf.WriteString("\n")
+			}
+			buf.WriteString("</details>\n")
+		}
+	}
+	return buf.String()
+}
+
+// renderMarkdownData renders a list of objects as a markdown table,
+// a single object as a key-value table, and the others as a JSON code block.
+func renderMarkdownData(data any, maxItems int, maxCellWidth int) string {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("```\n%s\n```\n", err.Error())
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		if table, ok := renderMarkdownObjectList(list, maxItems, maxCellWidth); ok {
+			return table
+		}
+	}
+	if keys, values, err := decodeOrderedObject(raw); err == nil {
+		var buf bytes.Buffer
+		buf.WriteString("| Key | Value |\n| --- | --- |\n")
+		for i, k := range keys {
+			fmt.Fprintf(&buf, "| %s | %s |\n",
+				markdownCell(k, maxCellWidth), markdownCell(string(values[i]), maxCellWidth))
+		}
+		return buf.String()
+	}
+
+	pretty, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		pretty = raw
+	}
+	return fmt.Sprintf("```json\n%s\n```\n", pretty)
+}
+
+func renderMarkdownObjectList(list []json.RawMessage, maxItems int, maxCellWidth int) (string, bool) {
+	if len(list) == 0 {
+		return "_empty_\n", true
+	}
+	columns := []string{}
+	seen := make(map[string]bool)
+	rows := []map[string]json.RawMessage{}
+	for _, item := range list {
+		keys, values, err := decodeOrderedObject(item)
+		if err != nil {
+			return "", false
+		}
+		row := make(map[string]json.RawMessage)
+		for i, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				columns = append(columns, k)
+			}
+			row[k] = values[i]
+		}
+		rows = append(rows, row)
+	}
+
+	truncated := 0
+	if maxItems > 0 && len(rows) > maxItems {
+		truncated = len(rows) - maxItems
+		rows = rows[:maxItems]
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("|")
+	for _, c := range columns {
+		fmt.Fprintf(&buf, " %s |", markdownCell(c, maxCellWidth))
+	}
+	buf.WriteString("\n|")
+	for range columns {
+		buf.WriteString(" --- |")
+	}
+	buf.WriteString("\n")
+	for _, row := range rows {
+		buf.WriteString("|")
+		for _, c := range columns {
+			fmt.Fprintf(&buf, " %s |", markdownCell(string(row[c]), maxCellWidth))
+		}
+		buf.WriteString("\n")
+	}
+	if truncated != 0 {
+		fmt.Fprintf(&buf, "\n_... and %d more items (truncated)_\n", truncated)
+	}
+	return buf.String(), true
+}
+
+// decodeOrderedObject decodes a JSON object, keeping the order of keys.
+func decodeOrderedObject(raw []byte) ([]string, []json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, nil, fmt.Errorf("not a json object")
+	}
+	keys := []string{}
+	values := []json.RawMessage{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected token %v", tok)
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
+
+func markdownCell(s string, maxWidth int) string {
+	// show plain strings without quotes
+	var str string
+	if err := json.Unmarshal([]byte(s), &str); err == nil {
+		s = str
+	}
+	if s == "null" {
+		s = ""
+	}
+	if maxWidth > 0 && len([]rune(s)) > maxWidth {
+		s = string([]rune(s)[:maxWidth]) + "..."
+	}
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r", "")
+	s = strings.ReplaceAll(s, "\n", "<br>")
+	return s
+}
+
+func escapeMarkdownHTML(s string) string {
+	s = strings.ReplaceAll(s, "&", "&amp;")
+	s = strings.ReplaceAll(s, "<", "&lt;")
+	s = strings.ReplaceAll(s, ">", "&gt;")
+	return s
+}
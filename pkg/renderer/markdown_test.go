@@ -0,0 +1,88 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kakao/detek/pkg/detek"
+)
+
+func TestRenderMarkdownReports(t *testing.T) {
+	type args struct {
+		r            detek.ReportList
+		maxItems     int
+		maxCellWidth int
+	}
+	tests := []struct {
+		name string
+		args args
+		want func(string) bool
+	}{
+		{
+			name: "test - 1",
+			args: args{
+				r: detek.ReportList{
+					StartedAt:  time.Now(),
+					FinishedAt: time.Now(),
+					Reports: []detek.Report{
+						generateDummyReport("1", detek.Fatal),
+						generateDummyReport("2", detek.Fatal),
+						generateDummyReport("3", detek.Warn),
+						generateDummyReport("4", detek.Warn),
+						generateDummyReport("5", detek.Error),
+						generateDummyReport("6", detek.Error),
+						generateDummyReport("7", detek.Normal),
+						generateDummyReport("8", detek.Normal),
+						generateDummyReport("9", detek.Unknown),
+						generateDummyReport("10", detek.Unknown),
+					},
+				},
+			},
+			want: func(s string) bool {
+				return strings.Contains(s, "| Fatal | 2 |") &&
+					strings.Count(s, "<details>") == 10 &&
+					strings.Count(s, "</details>") == 10
+			},
+		},
+		{
+			name: "truncated",
+			args: args{
+				r: detek.ReportList{
+					Reports: []detek.Report{
+						{
+							MetaInfo: detek.MetaInfo{ID: "list"},
+							Level:    detek.Error,
+							ReportSpec: detek.ReportSpec{
+								Problem: detek.JSONableData{
+									Description: "problems",
+									Data: []struct{ Name, Reason string }{
+										{"a", "pipe | in a cell"},
+										{"b", "long long long reason"},
+										{"c", "hidden"},
+									},
+								},
+							},
+						},
+					},
+				},
+				maxItems:     2,
+				maxCellWidth: 9,
+			},
+			want: func(s string) bool {
+				return strings.Contains(s, "| Name | Reason |") &&
+					strings.Contains(s, `| a | pipe \| in... |`) &&
+					strings.Contains(s, "| b | long long... |") &&
+					!strings.Contains(s, "hidden") &&
+					strings.Contains(s, "and 1 more items")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderMarkdownReports(tt.args.r, tt.args.maxItems, tt.args.maxCellWidth); !tt.want(got) {
+				t.Errorf("want() returned false:\n%s", got)
+			}
+		})
+	}
+}
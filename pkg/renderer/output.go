@@ -0,0 +1,83 @@
+package renderer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kakao/detek/pkg/detek"
+)
+
+// StdoutPath is a special path which means "print to stdout"
+const StdoutPath = "-"
+
+// Output is a destination of rendered reports.
+type Output struct {
+	Format Format
+	Path   string
+}
+
+// ParseOutput parses "<format>=<path>" (e.g, "html=report.html", "table=-").
+func ParseOutput(s string) (Output, error) {
+	format, path, ok := strings.Cut(s, "=")
+	if !ok || path == "" {
+		return Output{}, fmt.Errorf("%q is not a valid output, use <format>=<path> (path %q for stdout)", s, StdoutPath)
+	}
+	o := Output{Format: Format(format), Path: path}
+	if err := o.Format.IsValid(); err != nil {
+		return Output{}, err
+	}
+	return o, nil
+}
+
+// WriteReports renders the same ReportList into every given Output.
+// Files are written atomically, so readers never see a partially written report.
+func WriteReports(list *detek.ReportList, outputs []Output, opts RenderOpts, stdout io.Writer) error {
+	for _, o := range outputs {
+		rendered := RenderReports(list, o.Format, opts)
+		if o.Path == StdoutPath {
+			if _, err := fmt.Fprintln(stdout, rendered); err != nil {
+				return fmt.Errorf("fail to write %s report to stdout: %w", o.Format, err)
+			}
+			continue
+		}
+		if err := writeFileAtomic(o.Path, []byte(rendered)); err != nil {
+			return fmt.Errorf("fail to write %s report to %q: %w", o.Format, o.Path, err)
+		}
+	}
+	return nil
+}
+
+// writeFileAtomic writes data into path, keeping the mode of the existing file.
+// new files are only readable by the owner (0600), since reports may contain sensitive information (e.g, RBAC subjects, secret names).
+func writeFileAtomic(path string, data []byte) (err error) {
+	mode := os.FileMode(0600)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if _, err = f.Write(data); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Chmod(mode); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
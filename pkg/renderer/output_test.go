@@ -0,0 +1,86 @@
+package renderer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kakao/detek/pkg/detek"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    Output
+		wantErr bool
+	}{
+		{name: "file", arg: "html=report.html", want: Output{Format: FormatHTML, Path: "report.html"}},
+		{name: "stdout", arg: "table=-", want: Output{Format: FormatTable, Path: StdoutPath}},
+		{name: "no path", arg: "json=", wantErr: true},
+		{name: "no format", arg: "report.json", wantErr: true},
+		{name: "unknown format", arg: "xml=report.xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOutput(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseOutput() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteReports(t *testing.T) {
+	dir := t.TempDir()
+	list := &detek.ReportList{
+		StartedAt:  time.Now(),
+		FinishedAt: time.Now(),
+		Reports: []detek.Report{
+			generateDummyReport("1", detek.Fatal),
+			generateDummyReport("2", detek.Normal),
+		},
+	}
+	htmlPath := filepath.Join(dir, "report.html")
+	jsonPath := filepath.Join(dir, "report.json")
+	assert.NoError(t, os.WriteFile(jsonPath, []byte("old report"), 0640))
+
+	var stdout bytes.Buffer
+	err := WriteReports(list, []Output{
+		{Format: FormatHTML, Path: htmlPath},
+		{Format: FormatJSON, Path: jsonPath},
+		{Format: FormatTable, Path: StdoutPath},
+	}, RenderOpts{}, &stdout)
+	assert.NoError(t, err)
+
+	html, err := os.ReadFile(htmlPath)
+	assert.NoError(t, err)
+	assert.Equal(t, RenderHTMLReports(*list), string(html))
+
+	json, err := os.ReadFile(jsonPath)
+	assert.NoError(t, err)
+	assert.Equal(t, RenderJSONReports(*list, false), string(json))
+
+	assert.Equal(t, RenderTableReports(*list, 0)+"\n", stdout.String())
+
+	// new files are only readable by the owner, and existing files keep their modes
+	if fi, err := os.Stat(htmlPath); assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	}
+	if fi, err := os.Stat(jsonPath); assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0640), fi.Mode().Perm())
+	}
+
+	// no temporary files should be left
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	err = WriteReports(list, []Output{{Format: FormatJSON, Path: filepath.Join(dir, "no", "such", "dir.json")}}, RenderOpts{}, &stdout)
+	assert.Error(t, err)
+}
@@ -36,6 +36,12 @@ func RenderTablePlan(collectors []detek.Collector, detectors []detek.Detector) s
 		meta := d.GetMeta()
 		tw.AppendRow(table.Row{fmt.Sprintf("detctor-%d", seq), meta.ID, "desc", "description", meta.Description})
 		tw.AppendRow(table.Row{fmt.Sprintf("detctor-%d", seq), meta.ID, "desc", "severity", meta.Level})
+		levels := meta.LevelDescriptions()
+		for _, level := range []detek.SeverityLevel{detek.Fatal, detek.Error, detek.Warn, detek.Normal} {
+			if desc := levels.Get(level); desc != nil {
+				tw.AppendRow(table.Row{fmt.Sprintf("detctor-%d", seq), meta.ID, "level", level, desc.Explanation})
+			}
+		}
 		for key, info := range meta.Required {
 			tw.AppendRow(table.Row{fmt.Sprintf("detctor-%d", seq), meta.ID, "consume", key, info.Type.String()})
 		}
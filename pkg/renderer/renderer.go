@@ -14,22 +14,30 @@ type RenderOpts struct {
 	JSON struct {
 		Pretty bool
 	}
-	// YAML struct{}
+	Markdown struct {
+		// MaxItems limits the number of problem items per report (0 means unlimited)
+		MaxItems int
+		// MaxCellWidth truncates overflowed contents in table cells (0 means unlimited)
+		MaxCellWidth int
+	}
+	YAML struct{}
 }
 
 type Format string
 
 const (
-	FormatJSON  Format = "json"
-	FormatTable Format = "table"
-	FormatHTML  Format = "html"
+	FormatJSON     Format = "json"
+	FormatTable    Format = "table"
+	FormatHTML     Format = "html"
+	FormatMarkdown Format = "markdown"
+	FormatYAML     Format = "yaml"
 )
 
 func (f *Format) IsValid() error {
 	if f == nil {
 		return fmt.Errorf("this is nil")
 	}
-	for _, t := range []Format{FormatJSON, FormatTable, FormatHTML} {
+	for _, t := range []Format{FormatJSON, FormatTable, FormatHTML, FormatMarkdown, FormatYAML} {
 		if *f == t {
 			return nil
 		}
@@ -45,6 +53,10 @@ func RenderReports(list *detek.ReportList, format Format, opts RenderOpts) strin
 		return RenderJSONReports(*list, opts.JSON.Pretty)
 	case FormatTable:
 		return RenderTableReports(*list, opts.Table.MaxWidth)
+	case FormatYAML:
+		return RenderYAMLReports(*list)
+	case FormatMarkdown:
+		return RenderMarkdownReports(*list, opts.Markdown.MaxItems, opts.Markdown.MaxCellWidth)
 	default:
 		return "unsupported format"
 	}
@@ -0,0 +1,16 @@
+package renderer
+
+import (
+	"fmt"
+
+	"github.com/kakao/detek/pkg/detek"
+	"sigs.k8s.io/yaml"
+)
+
+func RenderYAMLReports(r detek.ReportList) string {
+	b, err := yaml.Marshal(r.Export())
+	if err != nil {
+		panic(fmt.Errorf("this is a bug: %w", err))
+	}
+	return string(b)
+}
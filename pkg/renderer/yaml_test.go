@@ -0,0 +1,54 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kakao/detek/pkg/detek"
+	"sigs.k8s.io/yaml"
+)
+
+func TestRenderYAMLReports(t *testing.T) {
+	type args struct {
+		r detek.ReportList
+	}
+	tests := []struct {
+		name string
+		args args
+		want func(string) bool
+	}{
+		{
+			name: "test - 1",
+			args: args{
+				r: detek.ReportList{
+					StartedAt:  time.Now(),
+					FinishedAt: time.Now(),
+					Reports: []detek.Report{
+						generateDummyReport("1", detek.Fatal),
+						generateDummyReport("2", detek.Warn),
+						generateDummyReport("3", detek.Error),
+						generateDummyReport("4", detek.Normal),
+						generateDummyReport("5", detek.Unknown),
+					},
+				},
+			},
+			want: func(s string) bool {
+				var list detek.ReportListExportingFormat
+				if err := yaml.Unmarshal([]byte(s), &list); err != nil {
+					return false
+				}
+				return len(list.Reports) == 5 &&
+					list.Reports[0].CurrentState == "Fatal state" &&
+					strings.Contains(s, "apiVersion: "+detek.ExportingAPIVersion)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderYAMLReports(tt.args.r); !tt.want(got) {
+				t.Errorf("want() return false")
+			}
+		})
+	}
+}